@@ -0,0 +1,73 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package client
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	restclient "k8s.io/client-go/rest"
+)
+
+// execCredsErr matches client-go exec credential plugin failures.
+const execCredsErr = "getting credentials"
+
+// AuthError represents an api server authentication failure.
+type AuthError struct {
+	Err  error
+	Hint string
+}
+
+// Error returns the error text.
+func (e *AuthError) Error() string {
+	return fmt.Sprintf("Authentication failed (%s). %s", e.Err, e.Hint)
+}
+
+// Unwrap returns the underlying error.
+func (e *AuthError) Unwrap() error {
+	return e.Err
+}
+
+// IsAuthExpired checks if an error signals expired or revoked credentials.
+func IsAuthExpired(err error) bool {
+	if err == nil {
+		return false
+	}
+	if apierrors.IsUnauthorized(err) {
+		return true
+	}
+
+	return strings.Contains(err.Error(), execCredsErr)
+}
+
+// NewAuthError classifies an authentication failure with actionable guidance.
+func NewAuthError(cfg *restclient.Config, err error) *AuthError {
+	return &AuthError{Err: err, Hint: authHint(cfg)}
+}
+
+func authHint(cfg *restclient.Config) string {
+	switch {
+	case cfg == nil:
+		return "Check the credentials configured in your kubeconfig"
+	case cfg.ExecProvider != nil:
+		return fmt.Sprintf("Token refresh via exec plugin %q failed. Log back in with your provider CLI, k9s will reconnect once credentials are valid",
+			filepath.Base(cfg.ExecProvider.Command))
+	case cfg.AuthProvider != nil:
+		return fmt.Sprintf("Token refresh via auth provider %q failed. Re-authenticate with your identity provider, k9s will reconnect once credentials are valid",
+			cfg.AuthProvider.Name)
+	default:
+		return "Check the credentials configured in your kubeconfig"
+	}
+}
+
+// AsAuthError checks if an error is an authentication failure.
+func AsAuthError(err error) (*AuthError, bool) {
+	var e *AuthError
+	ok := errors.As(err, &e)
+
+	return e, ok
+}
@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package client_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/rest"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+func TestIsAuthExpired(t *testing.T) {
+	uu := map[string]struct {
+		err error
+		e   bool
+	}{
+		"none": {},
+		"unauthorized": {
+			err: apierrors.NewUnauthorized("token expired"),
+			e:   true,
+		},
+		"exec": {
+			err: errors.New(`Get "https://k8s": getting credentials: exec: executable aws failed with exit code 255`),
+			e:   true,
+		},
+		"forbidden": {
+			err: apierrors.NewForbidden(*client.PodGVR.GR(), "fred", errors.New("denied")),
+		},
+		"other": {
+			err: errors.New("connection refused"),
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, client.IsAuthExpired(u.err))
+		})
+	}
+}
+
+func TestNewAuthError(t *testing.T) {
+	err := apierrors.NewUnauthorized("token expired")
+	uu := map[string]struct {
+		cfg  *rest.Config
+		hint string
+	}{
+		"none": {
+			hint: "Check the credentials configured in your kubeconfig",
+		},
+		"exec": {
+			cfg:  &rest.Config{ExecProvider: &clientcmdapi.ExecConfig{Command: "/usr/local/bin/kubelogin"}},
+			hint: `Token refresh via exec plugin "kubelogin" failed. Log back in with your provider CLI, k9s will reconnect once credentials are valid`,
+		},
+		"provider": {
+			cfg:  &rest.Config{AuthProvider: &clientcmdapi.AuthProviderConfig{Name: "oidc"}},
+			hint: `Token refresh via auth provider "oidc" failed. Re-authenticate with your identity provider, k9s will reconnect once credentials are valid`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			e := client.NewAuthError(u.cfg, err)
+			assert.Equal(t, u.hint, e.Hint)
+			assert.ErrorIs(t, e, err)
+
+			ae, ok := client.AsAuthError(fmt.Errorf("boom: %w", e))
+			assert.True(t, ok)
+			assert.Equal(t, e, ae)
+		})
+	}
+}
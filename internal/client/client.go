@@ -51,6 +51,7 @@ type APIClient struct {
 	mx                sync.RWMutex
 	cache             *cache.LRUExpireCache
 	connOK            bool
+	authErr           error
 	log               *slog.Logger
 }
 
@@ -319,18 +320,43 @@ func (a *APIClient) CheckConnectivity() bool {
 	}
 
 	// Check connection
-	if _, err := client.ServerVersion(); err == nil {
+	_, err = client.ServerVersion()
+	if IsAuthExpired(err) {
+		// Exec credentials are refreshed by the transport on 401, so retry once.
+		slog.Warn("Credentials expired. Re-authenticating...", slogs.Error, err)
+		_, err = client.ServerVersion()
+	}
+	if err == nil {
+		a.setAuthErr(nil)
 		if !a.getConnOK() {
 			a.reset()
 		}
 	} else {
 		slog.Error("Unable to fetch server version", slogs.Error, err)
+		if IsAuthExpired(err) {
+			a.setAuthErr(NewAuthError(cfg, err))
+		}
 		a.setConnOK(false)
 	}
 
 	return a.getConnOK()
 }
 
+// AuthError returns the last authentication failure if any.
+func (a *APIClient) AuthError() error {
+	a.mx.RLock()
+	defer a.mx.RUnlock()
+
+	return a.authErr
+}
+
+func (a *APIClient) setAuthErr(err error) {
+	a.mx.Lock()
+	defer a.mx.Unlock()
+
+	a.authErr = err
+}
+
 // Config return a kubernetes configuration.
 func (a *APIClient) Config() *Config {
 	return a.config
@@ -28,18 +28,25 @@ const (
 
 // Config tracks a kubernetes configuration.
 type Config struct {
-	flags *genericclioptions.ConfigFlags
-	mx    sync.RWMutex
-	proxy func(*http.Request) (*url.URL, error)
+	flags    *genericclioptions.ConfigFlags
+	mx       sync.RWMutex
+	proxy    func(*http.Request) (*url.URL, error)
+	warnings *Warnings
 }
 
 // NewConfig returns a new k8s config or an error if the flags are invalid.
 func NewConfig(f *genericclioptions.ConfigFlags) *Config {
 	return &Config{
-		flags: f,
+		flags:    f,
+		warnings: NewWarnings(),
 	}
 }
 
+// Warnings returns the api server warnings collected for the session.
+func (c *Config) Warnings() *Warnings {
+	return c.warnings
+}
+
 // CallTimeout returns the call timeout if set or the default if not set.
 func (c *Config) CallTimeout() time.Duration {
 	if !isSet(c.flags.Timeout) {
@@ -61,6 +68,9 @@ func (c *Config) RESTConfig() (*restclient.Config, error) {
 	if c.proxy != nil {
 		cfg.Proxy = c.proxy
 	}
+	if c.warnings != nil {
+		cfg.WarningHandler = c.warnings
+	}
 
 	return cfg, nil
 }
@@ -87,8 +97,25 @@ func (c *Config) SwitchContext(name string) error {
 		return fmt.Errorf("context %q does not exist", name)
 	}
 	// !!BOZO!! Do you need to reset the flags?
-	flags := genericclioptions.NewConfigFlags(UsePersistentConfig)
-	flags.Context, flags.ClusterName = &name, &ct.Cluster
+	c.flags = c.contextFlags(name, ct.Cluster, UsePersistentConfig)
+
+	return nil
+}
+
+// ContextRESTConfig returns a rest configuration for a given context without switching to it.
+// K9s context specific settings such as proxies are not applied.
+func (c *Config) ContextRESTConfig(name string) (*restclient.Config, error) {
+	ct, err := c.GetContext(name)
+	if err != nil {
+		return nil, fmt.Errorf("context %q does not exist", name)
+	}
+
+	return c.contextFlags(name, ct.Cluster, false).ToRawKubeConfigLoader().ClientConfig()
+}
+
+func (c *Config) contextFlags(name, cluster string, persistent bool) *genericclioptions.ConfigFlags {
+	flags := genericclioptions.NewConfigFlags(persistent)
+	flags.Context, flags.ClusterName = &name, &cluster
 	flags.Namespace = c.flags.Namespace
 	flags.Timeout = c.flags.Timeout
 	flags.KubeConfig = c.flags.KubeConfig
@@ -98,9 +125,7 @@ func (c *Config) SwitchContext(name string) error {
 	flags.Insecure = c.flags.Insecure
 	flags.BearerToken = c.flags.BearerToken
 
-	c.flags = flags
-
-	return nil
+	return flags
 }
 
 func (c *Config) Clone(ns string) (*genericclioptions.ConfigFlags, error) {
@@ -49,22 +49,24 @@ var (
 	PmxGVR = NewGVR("metrics.k8s.io/v1beta1/pods")
 
 	// K9s...
-	CpuGVR = NewGVR("cpu")
-	MemGVR = NewGVR("memory")
-	WkGVR  = NewGVR("workloads")
-	CoGVR  = NewGVR("containers")
-	CtGVR  = NewGVR("contexts")
-	RefGVR = NewGVR("references")
-	PuGVR  = NewGVR("pulses")
-	ScnGVR = NewGVR("scans")
-	DirGVR = NewGVR("dirs")
-	PfGVR  = NewGVR("portforwards")
-	SdGVR  = NewGVR("screendumps")
-	BeGVR  = NewGVR("benchmarks")
-	AliGVR = NewGVR("aliases")
-	XGVR   = NewGVR("xrays")
-	HlpGVR = NewGVR("help")
-	QGVR   = NewGVR("quit")
+	CpuGVR  = NewGVR("cpu")
+	MemGVR  = NewGVR("memory")
+	WkGVR   = NewGVR("workloads")
+	CoGVR   = NewGVR("containers")
+	CtGVR   = NewGVR("contexts")
+	RefGVR  = NewGVR("references")
+	PuGVR   = NewGVR("pulses")
+	ScnGVR  = NewGVR("scans")
+	DirGVR  = NewGVR("dirs")
+	PfGVR   = NewGVR("portforwards")
+	SdGVR   = NewGVR("screendumps")
+	BeGVR   = NewGVR("benchmarks")
+	WarnGVR = NewGVR("warnings")
+	FlGVR   = NewGVR("fleets")
+	AliGVR  = NewGVR("aliases")
+	XGVR    = NewGVR("xrays")
+	HlpGVR  = NewGVR("help")
+	QGVR    = NewGVR("quit")
 
 	// Helm...
 	HmGVR  = NewGVR("helm")
@@ -94,6 +96,8 @@ var reservedGVRs = sets.New(
 	PfGVR,
 	SdGVR,
 	BeGVR,
+	WarnGVR,
+	FlGVR,
 	AliGVR,
 	XGVR,
 	HlpGVR,
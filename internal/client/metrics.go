@@ -20,6 +20,12 @@ import (
 const (
 	mxCacheSize   = 100
 	mxCacheExpiry = 1 * time.Minute
+
+	// CPUCores renders cpu quantities in cores.
+	CPUCores = "cores"
+
+	// CPUMillicores renders cpu quantities in millicores.
+	CPUMillicores = "millicores"
 )
 
 // MetricsDial tracks global metric server handle.
@@ -132,6 +132,9 @@ type Connection interface {
 	// CheckConnectivity checks if api server connection is happy or not.
 	CheckConnectivity() bool
 
+	// AuthError returns the last authentication failure if any.
+	AuthError() error
+
 	// ActiveContext returns the current context name.
 	ActiveContext() string
 
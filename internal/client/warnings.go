@@ -0,0 +1,132 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package client
+
+import (
+	"strconv"
+	"sync"
+	"time"
+
+	restclient "k8s.io/client-go/rest"
+)
+
+// maxWarnings tracks the max number of distinct warnings kept per session.
+const maxWarnings = 100
+
+var _ restclient.WarningHandler = (*Warnings)(nil)
+
+// Warning represents an api server warning.
+type Warning struct {
+	ID          string
+	Code        int
+	Agent       string
+	Text        string
+	Count       int
+	First, Last time.Time
+}
+
+// WarningListener represents an api server warnings listener.
+type WarningListener interface {
+	// APIWarning notifies a new distinct warning was received.
+	APIWarning(Warning)
+}
+
+// Warnings collects deduplicated api server warnings for the session.
+type Warnings struct {
+	ww       map[string]*Warning
+	order    []string
+	seq      int
+	listener WarningListener
+	mx       sync.RWMutex
+}
+
+// NewWarnings returns a new warnings collector.
+func NewWarnings() *Warnings {
+	return &Warnings{
+		ww: make(map[string]*Warning),
+	}
+}
+
+// SetListener registers a warnings listener.
+func (w *Warnings) SetListener(l WarningListener) {
+	w.mx.Lock()
+	defer w.mx.Unlock()
+
+	w.listener = l
+}
+
+// HandleWarningHeader records an api server warning header.
+// Identical warnings are only tallied and do not notify the listener again.
+func (w *Warnings) HandleWarningHeader(code int, agent, text string) {
+	// Only 299 warnings are defined by RFC7234 for api server warnings.
+	if code != 299 || text == "" {
+		return
+	}
+
+	w.mx.Lock()
+	now := time.Now()
+	if warn, ok := w.ww[text]; ok {
+		warn.Count++
+		warn.Last = now
+		w.mx.Unlock()
+		return
+	}
+	w.seq++
+	warn := Warning{
+		ID:    strconv.Itoa(w.seq),
+		Code:  code,
+		Agent: agent,
+		Text:  text,
+		Count: 1,
+		First: now,
+		Last:  now,
+	}
+	w.ww[text], w.order = &warn, append(w.order, text)
+	if len(w.order) > maxWarnings {
+		delete(w.ww, w.order[0])
+		w.order = w.order[1:]
+	}
+	l := w.listener
+	w.mx.Unlock()
+
+	if l != nil {
+		l.APIWarning(warn)
+	}
+}
+
+// List returns all collected warnings, oldest first.
+func (w *Warnings) List() []Warning {
+	w.mx.RLock()
+	defer w.mx.RUnlock()
+
+	ww := make([]Warning, 0, len(w.order))
+	for _, k := range w.order {
+		ww = append(ww, *w.ww[k])
+	}
+
+	return ww
+}
+
+// Delete removes a warning by id.
+func (w *Warnings) Delete(id string) {
+	w.mx.Lock()
+	defer w.mx.Unlock()
+
+	for i, k := range w.order {
+		if w.ww[k].ID == id {
+			delete(w.ww, k)
+			w.order = append(w.order[:i], w.order[i+1:]...)
+			return
+		}
+	}
+}
+
+// Clear removes all warnings.
+func (w *Warnings) Clear() {
+	w.mx.Lock()
+	defer w.mx.Unlock()
+
+	clear(w.ww)
+	w.order = nil
+}
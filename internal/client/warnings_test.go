@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package client_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWarningsHandle(t *testing.T) {
+	uu := map[string]struct {
+		hh    [][2]string
+		codes []int
+		e     []string
+		cc    []int
+	}{
+		"empty": {},
+		"dedup": {
+			hh: [][2]string{
+				{"kube-apiserver", "v1 Endpoints is deprecated"},
+				{"kube-apiserver", "v1 Endpoints is deprecated"},
+				{"webhook", "missing team label"},
+			},
+			codes: []int{299, 299, 299},
+			e:     []string{"v1 Endpoints is deprecated", "missing team label"},
+			cc:    []int{2, 1},
+		},
+		"non-299": {
+			hh:    [][2]string{{"", "blee"}},
+			codes: []int{199},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var l warnListener
+			ww := client.NewWarnings()
+			ww.SetListener(&l)
+			for i, h := range u.hh {
+				ww.HandleWarningHeader(u.codes[i], h[0], h[1])
+			}
+
+			list := ww.List()
+			assert.Len(t, list, len(u.e))
+			assert.Equal(t, u.e, l.texts)
+			for i := range list {
+				assert.Equal(t, u.e[i], list[i].Text)
+				assert.Equal(t, u.cc[i], list[i].Count)
+			}
+		})
+	}
+}
+
+func TestWarningsDelete(t *testing.T) {
+	ww := client.NewWarnings()
+	ww.HandleWarningHeader(299, "", "fred")
+	ww.HandleWarningHeader(299, "", "blee")
+
+	ww.Delete(ww.List()[0].ID)
+	assert.Len(t, ww.List(), 1)
+	assert.Equal(t, "blee", ww.List()[0].Text)
+
+	ww.Clear()
+	assert.Empty(t, ww.List())
+}
+
+// Helpers...
+
+type warnListener struct {
+	texts []string
+}
+
+func (l *warnListener) APIWarning(w client.Warning) {
+	l.texts = append(l.texts, w.Text)
+}
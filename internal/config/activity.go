@@ -0,0 +1,37 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+import (
+	"time"
+)
+
+// DefaultActivityWindow tracks how long a resource update stays highlighted.
+const DefaultActivityWindow = 10 * time.Second
+
+// Activity tracks recently updated resources highlighting options.
+type Activity struct {
+	Enable bool `json:"enable" yaml:"enable"`
+
+	// Window tracks how long an update stays highlighted. Default 10s.
+	Window string `json:"window,omitempty" yaml:"window,omitempty"`
+}
+
+// IsEnabled checks if activity highlighting is on.
+func (a *Activity) IsEnabled() bool {
+	return a != nil && a.Enable
+}
+
+// WindowDuration returns how long an update stays highlighted.
+func (a *Activity) WindowDuration() time.Duration {
+	if a == nil {
+		return DefaultActivityWindow
+	}
+	d, err := time.ParseDuration(a.Window)
+	if err != nil || d <= 0 {
+		return DefaultActivityWindow
+	}
+
+	return d
+}
@@ -193,8 +193,10 @@ func (a *Aliases) loadDefaultAliases() {
 	a.declare(client.PfGVR, "portforward", "pf")
 	a.declare(client.BeGVR, "benchmark", "bench")
 	a.declare(client.SdGVR, "screendump", "sd")
+	a.declare(client.WarnGVR, "warnings", "warning", "warn")
 	a.declare(client.PuGVR, "pulse", "pu", "hz")
 	a.declare(client.XGVR, "xray", "x")
+	a.declare(client.FlGVR, "fleet", "fl")
 	a.declare(client.WkGVR, "workload", "wk")
 }
 
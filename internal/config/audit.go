@@ -0,0 +1,154 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/config/data"
+)
+
+const (
+	// AuditDelete tracks resource deletions.
+	AuditDelete = "delete"
+
+	// AuditKill tracks pod kills.
+	AuditKill = "kill"
+
+	// AuditScale tracks workload scaling.
+	AuditScale = "scale"
+
+	// AuditEdit tracks resource edits.
+	AuditEdit = "edit"
+
+	// AuditDrain tracks node drains.
+	AuditDrain = "drain"
+
+	// AuditCordon tracks node cordons.
+	AuditCordon = "cordon"
+
+	// AuditUncordon tracks node uncordons.
+	AuditUncordon = "uncordon"
+
+	// AuditRestart tracks workload rollout restarts.
+	AuditRestart = "restart"
+
+	// AuditRollback tracks workload and release rollbacks.
+	AuditRollback = "rollback"
+
+	// AuditAnnotate tracks resource annotations and reconcile triggers.
+	AuditAnnotate = "annotate"
+
+	// AuditFinalizer tracks finalizers removals.
+	AuditFinalizer = "finalizer"
+
+	// AuditSetImage tracks container images updates.
+	AuditSetImage = "set-image"
+
+	// AuditApply tracks manifests applies.
+	AuditApply = "apply"
+
+	auditFileMod os.FileMode = 0600
+)
+
+// DefaultAuditActions tracks all auditable actions.
+var DefaultAuditActions = []string{
+	AuditDelete,
+	AuditKill,
+	AuditScale,
+	AuditEdit,
+	AuditDrain,
+	AuditCordon,
+	AuditUncordon,
+	AuditRestart,
+	AuditRollback,
+	AuditAnnotate,
+	AuditFinalizer,
+	AuditSetImage,
+	AuditApply,
+}
+
+// AuditRecord represents a mutating action audit entry.
+type AuditRecord struct {
+	Time    time.Time `json:"time"`
+	Context string    `json:"context"`
+	User    string    `json:"user"`
+	Action  string    `json:"action"`
+	GVR     string    `json:"gvr"`
+	Target  string    `json:"target"`
+	Error   string    `json:"error,omitempty"`
+}
+
+// Audit tracks mutating actions audit log options.
+type Audit struct {
+	Enable  bool     `json:"enable" yaml:"enable"`
+	File    string   `json:"file" yaml:"file,omitempty"`
+	Actions []string `json:"actions" yaml:"actions,omitempty"`
+
+	mx sync.Mutex
+}
+
+// NewAudit returns a new instance.
+func NewAudit() *Audit {
+	return &Audit{}
+}
+
+// Path returns the audit log file path.
+func (a *Audit) Path() string {
+	if a.File != "" {
+		return a.File
+	}
+
+	return filepath.Join(filepath.Dir(AppContextsDir), "audit.log")
+}
+
+// Audits checks if a given action must be audited.
+// All known actions are audited when none are specified.
+func (a *Audit) Audits(action string) bool {
+	if a == nil || !a.Enable {
+		return false
+	}
+	if len(a.Actions) == 0 {
+		return slices.Contains(DefaultAuditActions, action)
+	}
+
+	return slices.Contains(a.Actions, action)
+}
+
+// Record appends an audit entry to the audit log if the action is audited.
+func (a *Audit) Record(r AuditRecord) error {
+	if !a.Audits(r.Action) {
+		return nil
+	}
+	if r.Time.IsZero() {
+		r.Time = time.Now()
+	}
+	bb, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+
+	a.mx.Lock()
+	defer a.mx.Unlock()
+
+	path := a.Path()
+	if err := data.EnsureDirPath(path, data.DefaultDirMod); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, auditFileMod)
+	if err != nil {
+		return fmt.Errorf("unable to open audit log %q: %w", path, err)
+	}
+	defer f.Close()
+
+	_, err = f.Write(append(bb, '\n'))
+
+	return err
+}
@@ -0,0 +1,107 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditAudits(t *testing.T) {
+	uu := map[string]struct {
+		audit  *config.Audit
+		action string
+		e      bool
+	}{
+		"nil": {
+			action: config.AuditDelete,
+		},
+		"disabled": {
+			audit:  &config.Audit{},
+			action: config.AuditDelete,
+		},
+		"defaults": {
+			audit:  &config.Audit{Enable: true},
+			action: config.AuditDrain,
+			e:      true,
+		},
+		"defaults-set-image": {
+			audit:  &config.Audit{Enable: true},
+			action: config.AuditSetImage,
+			e:      true,
+		},
+		"defaults-apply": {
+			audit:  &config.Audit{Enable: true},
+			action: config.AuditApply,
+			e:      true,
+		},
+		"unknown": {
+			audit:  &config.Audit{Enable: true},
+			action: "fred",
+		},
+		"included": {
+			audit:  &config.Audit{Enable: true, Actions: []string{config.AuditScale}},
+			action: config.AuditScale,
+			e:      true,
+		},
+		"excluded": {
+			audit:  &config.Audit{Enable: true, Actions: []string{config.AuditScale}},
+			action: config.AuditDelete,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, u.audit.Audits(u.action))
+		})
+	}
+}
+
+func TestAuditRecord(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit", "audit.log")
+	a := config.Audit{
+		Enable:  true,
+		File:    path,
+		Actions: []string{config.AuditDelete, config.AuditScale},
+	}
+
+	require.NoError(t, a.Record(config.AuditRecord{
+		Context: "ct1",
+		User:    "fred",
+		Action:  config.AuditDelete,
+		GVR:     "v1/pods",
+		Target:  "default/p1",
+	}))
+	require.NoError(t, a.Record(config.AuditRecord{Action: config.AuditEdit, Target: "default/p2"}))
+	require.NoError(t, a.Record(config.AuditRecord{
+		Action: config.AuditScale,
+		Target: "default/d1 replicas=0",
+		Error:  "boom",
+	}))
+
+	bb, err := os.ReadFile(path)
+	require.NoError(t, err)
+	ll := strings.Split(strings.TrimSpace(string(bb)), "\n")
+	assert.Len(t, ll, 2)
+
+	var r config.AuditRecord
+	require.NoError(t, json.Unmarshal([]byte(ll[0]), &r))
+	assert.Equal(t, "ct1", r.Context)
+	assert.Equal(t, "fred", r.User)
+	assert.Equal(t, config.AuditDelete, r.Action)
+	assert.Equal(t, "default/p1", r.Target)
+	assert.False(t, r.Time.IsZero())
+	assert.Empty(t, r.Error)
+
+	require.NoError(t, json.Unmarshal([]byte(ll[1]), &r))
+	assert.Equal(t, "boom", r.Error)
+}
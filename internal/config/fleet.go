@@ -0,0 +1,19 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+// Fleet tracks multi contexts aggregated views options.
+type Fleet struct {
+	// Contexts lists the contexts participating in aggregated views.
+	Contexts []string `json:"contexts,omitempty" yaml:"contexts,omitempty"`
+}
+
+// ContextNames returns the fleet contexts if any.
+func (f *Fleet) ContextNames() []string {
+	if f == nil {
+		return nil
+	}
+
+	return f.Contexts
+}
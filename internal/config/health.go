@@ -0,0 +1,94 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+import (
+	"time"
+)
+
+// DefaultHealthStaleAfter tracks how long a condition may fail before turning critical.
+const DefaultHealthStaleAfter = 5 * time.Minute
+
+// HealthRule tracks a resource kind health scoring rule.
+type HealthRule struct {
+	// Conditions lists status condition types expected to be True.
+	Conditions []string `json:"conditions,omitempty" yaml:"conditions,omitempty"`
+
+	// StaleAfter tracks how long a condition may fail before turning critical. Default 5m.
+	StaleAfter string `json:"staleAfter,omitempty" yaml:"staleAfter,omitempty"`
+
+	// RestartsWarn and RestartsCrit track container restarts thresholds.
+	RestartsWarn int64 `json:"restartsWarn,omitempty" yaml:"restartsWarn,omitempty"`
+	RestartsCrit int64 `json:"restartsCrit,omitempty" yaml:"restartsCrit,omitempty"`
+
+	// Replicas compares ready vs desired replicas.
+	Replicas bool `json:"replicas,omitempty" yaml:"replicas,omitempty"`
+
+	// EventsWarn and EventsCrit track warning events thresholds.
+	EventsWarn int `json:"eventsWarn,omitempty" yaml:"eventsWarn,omitempty"`
+	EventsCrit int `json:"eventsCrit,omitempty" yaml:"eventsCrit,omitempty"`
+}
+
+// HealthRules tracks health rules keyed by group/version/resource.
+type HealthRules map[string]HealthRule
+
+// DefaultHealthRules tracks opinionated health rules for common kinds.
+var DefaultHealthRules = HealthRules{
+	"v1/pods": {
+		Conditions:   []string{"Ready"},
+		RestartsWarn: 3,
+		RestartsCrit: 10,
+		EventsWarn:   5,
+	},
+	"v1/nodes": {
+		Conditions: []string{"Ready"},
+	},
+	"apps/v1/deployments": {
+		Conditions: []string{"Available"},
+		Replicas:   true,
+	},
+	"apps/v1/statefulsets": {
+		Replicas: true,
+	},
+	"apps/v1/daemonsets": {
+		Replicas: true,
+	},
+	"apps/v1/replicasets": {
+		Replicas: true,
+	},
+}
+
+// Health tracks resource health scoring options.
+type Health struct {
+	Enable bool        `json:"enable" yaml:"enable"`
+	Rules  HealthRules `json:"rules,omitempty" yaml:"rules,omitempty"`
+}
+
+// IsEnabled checks if health scoring is on.
+func (h *Health) IsEnabled() bool {
+	return h != nil && h.Enable
+}
+
+// RuleFor returns a health rule for a given resource if any.
+// User defined rules take precedence over the default ones.
+func (h *Health) RuleFor(gvr string) (HealthRule, bool) {
+	if h != nil {
+		if r, ok := h.Rules[gvr]; ok {
+			return r, true
+		}
+	}
+	r, ok := DefaultHealthRules[gvr]
+
+	return r, ok
+}
+
+// StaleDuration returns how long a condition may fail before turning critical.
+func (r HealthRule) StaleDuration() time.Duration {
+	d, err := time.ParseDuration(r.StaleAfter)
+	if err != nil || d <= 0 {
+		return DefaultHealthStaleAfter
+	}
+
+	return d
+}
@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHealthRuleFor(t *testing.T) {
+	h := config.Health{
+		Enable: true,
+		Rules: config.HealthRules{
+			"v1/pods":         {RestartsWarn: 1},
+			"acme.io/v1/fred": {Conditions: []string{"Ready"}},
+		},
+	}
+
+	uu := map[string]struct {
+		h   *config.Health
+		gvr string
+		ok  bool
+		e   config.HealthRule
+	}{
+		"override": {
+			h:   &h,
+			gvr: "v1/pods",
+			ok:  true,
+			e:   config.HealthRule{RestartsWarn: 1},
+		},
+		"custom": {
+			h:   &h,
+			gvr: "acme.io/v1/fred",
+			ok:  true,
+			e:   config.HealthRule{Conditions: []string{"Ready"}},
+		},
+		"default": {
+			h:   &h,
+			gvr: "v1/nodes",
+			ok:  true,
+			e:   config.HealthRule{Conditions: []string{"Ready"}},
+		},
+		"nil": {
+			gvr: "apps/v1/replicasets",
+			ok:  true,
+			e:   config.HealthRule{Replicas: true},
+		},
+		"unknown": {
+			h:   &h,
+			gvr: "v1/configmaps",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			r, ok := u.h.RuleFor(u.gvr)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.e, r)
+		})
+	}
+}
+
+func TestHealthRuleStaleDuration(t *testing.T) {
+	assert.Equal(t, config.DefaultHealthStaleAfter, config.HealthRule{}.StaleDuration())
+	assert.Equal(t, config.DefaultHealthStaleAfter, config.HealthRule{StaleAfter: "bozo"}.StaleDuration())
+	assert.Equal(t, 2*time.Minute, config.HealthRule{StaleAfter: "2m"}.StaleDuration())
+}
@@ -34,23 +34,28 @@ var defaultGPUVendors = gpuVendors{
 
 // K9s tracks K9s configuration options.
 type K9s struct {
-	LiveViewAutoRefresh bool       `json:"liveViewAutoRefresh" yaml:"liveViewAutoRefresh"`
-	GPUVendors          gpuVendors `json:"gpuVendors" yaml:"gpuVendors"`
-	ScreenDumpDir       string     `json:"screenDumpDir" yaml:"screenDumpDir,omitempty"`
-	RefreshRate         float32    `json:"refreshRate" yaml:"refreshRate"`
-	APIServerTimeout    string     `json:"apiServerTimeout" yaml:"apiServerTimeout"`
-	MaxConnRetry        int32      `json:"maxConnRetry" yaml:"maxConnRetry"`
-	ReadOnly            bool       `json:"readOnly" yaml:"readOnly"`
-	NoExitOnCtrlC       bool       `json:"noExitOnCtrlC" yaml:"noExitOnCtrlC"`
-	PortForwardAddress  string     `yaml:"portForwardAddress"`
-	UI                  UI         `json:"ui" yaml:"ui"`
-	SkipLatestRevCheck  bool       `json:"skipLatestRevCheck" yaml:"skipLatestRevCheck"`
-	DisablePodCounting  bool       `json:"disablePodCounting" yaml:"disablePodCounting"`
-	ShellPod            *ShellPod  `json:"shellPod" yaml:"shellPod"`
-	ImageScans          ImageScans `json:"imageScans" yaml:"imageScans"`
-	Logger              Logger     `json:"logger" yaml:"logger"`
-	Thresholds          Threshold  `json:"thresholds" yaml:"thresholds"`
-	DefaultView         string     `json:"defaultView" yaml:"defaultView"`
+	LiveViewAutoRefresh bool        `json:"liveViewAutoRefresh" yaml:"liveViewAutoRefresh"`
+	GPUVendors          gpuVendors  `json:"gpuVendors" yaml:"gpuVendors"`
+	ScreenDumpDir       string      `json:"screenDumpDir" yaml:"screenDumpDir,omitempty"`
+	RefreshRate         float32     `json:"refreshRate" yaml:"refreshRate"`
+	APIServerTimeout    string      `json:"apiServerTimeout" yaml:"apiServerTimeout"`
+	MaxConnRetry        int32       `json:"maxConnRetry" yaml:"maxConnRetry"`
+	ReadOnly            bool        `json:"readOnly" yaml:"readOnly"`
+	NoExitOnCtrlC       bool        `json:"noExitOnCtrlC" yaml:"noExitOnCtrlC"`
+	PortForwardAddress  string      `yaml:"portForwardAddress"`
+	UI                  UI          `json:"ui" yaml:"ui"`
+	SkipLatestRevCheck  bool        `json:"skipLatestRevCheck" yaml:"skipLatestRevCheck"`
+	DisablePodCounting  bool        `json:"disablePodCounting" yaml:"disablePodCounting"`
+	ShellPod            *ShellPod   `json:"shellPod" yaml:"shellPod"`
+	ImageScans          ImageScans  `json:"imageScans" yaml:"imageScans"`
+	Logger              Logger      `json:"logger" yaml:"logger"`
+	Thresholds          Threshold   `json:"thresholds" yaml:"thresholds"`
+	DefaultView         string      `json:"defaultView" yaml:"defaultView"`
+	Audit               *Audit      `json:"audit" yaml:"audit,omitempty"`
+	Reconcilers         Reconcilers `json:"reconcilers,omitempty" yaml:"reconcilers,omitempty"`
+	Health              *Health     `json:"health" yaml:"health,omitempty"`
+	Activity            *Activity   `json:"activity" yaml:"activity,omitempty"`
+	Fleet               *Fleet      `json:"fleet" yaml:"fleet,omitempty"`
 	manualRefreshRate   float32
 	manualReadOnly      *bool
 	manualCommand       *string
@@ -153,6 +158,21 @@ func (k *K9s) Merge(k1 *K9s) {
 	if k1.Thresholds != nil {
 		k.Thresholds = k1.Thresholds
 	}
+	if k1.Audit != nil {
+		k.Audit = k1.Audit
+	}
+	if k1.Reconcilers != nil {
+		k.Reconcilers = k1.Reconcilers
+	}
+	if k1.Health != nil {
+		k.Health = k1.Health
+	}
+	if k1.Activity != nil {
+		k.Activity = k1.Activity
+	}
+	if k1.Fleet != nil {
+		k.Fleet = k1.Fleet
+	}
 }
 
 // AppScreenDumpDir fetch screen dumps dir.
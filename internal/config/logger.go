@@ -7,6 +7,9 @@ const (
 	// DefaultLoggerTailCount tracks default log tail size.
 	DefaultLoggerTailCount = 100
 
+	// DefaultSnapshotTailCount tracks default log snapshot size.
+	DefaultSnapshotTailCount = 500
+
 	// MaxLogThreshold sets the max value for log size.
 	MaxLogThreshold = 5_000
 
@@ -17,17 +20,25 @@ const (
 // Logger tracks logger options.
 type Logger struct {
 	TailCount         int64 `json:"tail" yaml:"tail"`
+	SnapshotTail      int64 `json:"snapshotTail" yaml:"snapshotTail"`
 	BufferSize        int   `json:"buffer" yaml:"buffer"`
 	SinceSeconds      int64 `json:"sinceSeconds" yaml:"sinceSeconds"`
 	TextWrap          bool  `json:"textWrap" yaml:"textWrap"`
 	DisableAutoscroll bool  `json:"disableAutoscroll" yaml:"disableAutoscroll"`
 	ShowTime          bool  `json:"showTime" yaml:"showTime"`
+
+	// DisableLevelColors turns off coloring log lines by detected level.
+	DisableLevelColors bool `json:"disableLevelColors" yaml:"disableLevelColors"`
+
+	// LevelPatterns overrides log level detection regexes keyed by debug, info, warn, error or fatal.
+	LevelPatterns map[string]string `json:"levelPatterns,omitempty" yaml:"levelPatterns,omitempty"`
 }
 
 // NewLogger returns a new instance.
 func NewLogger() Logger {
 	return Logger{
 		TailCount:    DefaultLoggerTailCount,
+		SnapshotTail: DefaultSnapshotTailCount,
 		BufferSize:   MaxLogThreshold,
 		SinceSeconds: DefaultSinceSeconds,
 	}
@@ -41,6 +52,9 @@ func (l Logger) Validate() Logger {
 	if l.TailCount > MaxLogThreshold {
 		l.TailCount = MaxLogThreshold
 	}
+	if l.SnapshotTail <= 0 || l.SnapshotTail > MaxLogThreshold {
+		l.SnapshotTail = DefaultSnapshotTailCount
+	}
 	if l.BufferSize <= 0 || l.BufferSize > MaxLogThreshold {
 		l.BufferSize = MaxLogThreshold
 	}
@@ -0,0 +1,114 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/derailed/k9s/internal/config/data"
+	"gopkg.in/yaml.v3"
+)
+
+type (
+	// Note tracks resource fqn to note mappings.
+	Note map[string]string
+
+	// Notes represents a collection of local resource notes keyed by gvr.
+	Notes struct {
+		Notes map[string]Note `yaml:"notes"`
+		path  string
+		mx    sync.RWMutex
+	}
+)
+
+// NewNotes returns a new notes store.
+func NewNotes() *Notes {
+	return &Notes{
+		Notes: make(map[string]Note),
+	}
+}
+
+// Load loads notes from a given file. Missing files yield an empty store.
+func (n *Notes) Load(path string) error {
+	n.mx.Lock()
+	defer n.mx.Unlock()
+
+	n.path, n.Notes = path, make(map[string]Note)
+	if path == "" {
+		return nil
+	}
+	if _, err := os.Stat(path); errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	bb, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if err := yaml.Unmarshal(bb, n); err != nil {
+		return err
+	}
+	if n.Notes == nil {
+		n.Notes = make(map[string]Note)
+	}
+
+	return nil
+}
+
+// Get returns a resource note if any.
+func (n *Notes) Get(gvr, fqn string) (string, bool) {
+	n.mx.RLock()
+	defer n.mx.RUnlock()
+
+	note, ok := n.Notes[gvr][fqn]
+
+	return note, ok
+}
+
+// For returns all notes for a given resource type.
+func (n *Notes) For(gvr string) Note {
+	n.mx.RLock()
+	defer n.mx.RUnlock()
+
+	nn := make(Note, len(n.Notes[gvr]))
+	for k, v := range n.Notes[gvr] {
+		nn[k] = v
+	}
+
+	return nn
+}
+
+// Set sets or clears a resource note if blank and persists the store.
+func (n *Notes) Set(gvr, fqn, note string) error {
+	n.mx.Lock()
+	defer n.mx.Unlock()
+
+	if note = strings.TrimSpace(note); note == "" {
+		delete(n.Notes[gvr], fqn)
+		if len(n.Notes[gvr]) == 0 {
+			delete(n.Notes, gvr)
+		}
+	} else {
+		if _, ok := n.Notes[gvr]; !ok {
+			n.Notes[gvr] = make(Note)
+		}
+		n.Notes[gvr][fqn] = note
+	}
+
+	return n.save()
+}
+
+func (n *Notes) save() error {
+	if n.path == "" {
+		return errors.New("no active context notes file")
+	}
+	if err := data.EnsureDirPath(n.path, data.DefaultDirMod); err != nil {
+		return err
+	}
+
+	return data.SaveYAML(n.path, n)
+}
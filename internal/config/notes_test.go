@@ -0,0 +1,86 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotesLoadMissing(t *testing.T) {
+	n := config.NewNotes()
+	require.NoError(t, n.Load(filepath.Join(t.TempDir(), "notes.yaml")))
+
+	_, ok := n.Get("v1/pods", "default/fred")
+	assert.False(t, ok)
+}
+
+func TestNotesSetNoPath(t *testing.T) {
+	n := config.NewNotes()
+
+	require.Error(t, n.Set("v1/pods", "default/fred", "blee"))
+}
+
+func TestNotesPersist(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cl-1", "ct-1", "notes.yaml")
+
+	n := config.NewNotes()
+	require.NoError(t, n.Load(path))
+	require.NoError(t, n.Set("v1/pods", "default/fred", "  known flaky, ticket-1234 "))
+	require.NoError(t, n.Set("v1/pods", "default/zorg", "blee"))
+	require.NoError(t, n.Set("apps/v1/deployments", "default/fred", "duh"))
+
+	uu := map[string]struct {
+		gvr, fqn, e string
+		ok          bool
+	}{
+		"pod": {
+			gvr: "v1/pods",
+			fqn: "default/fred",
+			e:   "known flaky, ticket-1234",
+			ok:  true,
+		},
+		"dp": {
+			gvr: "apps/v1/deployments",
+			fqn: "default/fred",
+			e:   "duh",
+			ok:  true,
+		},
+		"missing": {
+			gvr: "v1/services",
+			fqn: "default/fred",
+		},
+	}
+
+	l := config.NewNotes()
+	require.NoError(t, l.Load(path))
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			note, ok := l.Get(u.gvr, u.fqn)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.e, note)
+		})
+	}
+	assert.Len(t, l.For("v1/pods"), 2)
+}
+
+func TestNotesClear(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "notes.yaml")
+
+	n := config.NewNotes()
+	require.NoError(t, n.Load(path))
+	require.NoError(t, n.Set("v1/pods", "default/fred", "blee"))
+	require.NoError(t, n.Set("v1/pods", "default/fred", " "))
+
+	l := config.NewNotes()
+	require.NoError(t, l.Load(path))
+	_, ok := l.Get("v1/pods", "default/fred")
+	assert.False(t, ok)
+	assert.Empty(t, l.For("v1/pods"))
+}
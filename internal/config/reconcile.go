@@ -0,0 +1,84 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+)
+
+// Reconciler tracks a resource reconcile trigger.
+// The trigger value defaults to the current time when not set. Labels get
+// unix seconds since RFC3339 timestamps are not valid label values.
+type Reconciler struct {
+	Annotation string `json:"annotation,omitempty" yaml:"annotation,omitempty"`
+	Label      string `json:"label,omitempty" yaml:"label,omitempty"`
+	Value      string `json:"value,omitempty" yaml:"value,omitempty"`
+}
+
+// Reconcilers tracks reconcile triggers keyed by either group/version/resource
+// or resource.group for version agnostic matches.
+type Reconcilers map[string]Reconciler
+
+// DefaultReconcilers tracks reconcile triggers for well known operators.
+var DefaultReconcilers = Reconcilers{
+	"kustomizations.kustomize.toolkit.fluxcd.io":     {Annotation: fluxReconcileKey},
+	"helmreleases.helm.toolkit.fluxcd.io":            {Annotation: fluxReconcileKey},
+	"gitrepositories.source.toolkit.fluxcd.io":       {Annotation: fluxReconcileKey},
+	"helmrepositories.source.toolkit.fluxcd.io":      {Annotation: fluxReconcileKey},
+	"helmcharts.source.toolkit.fluxcd.io":            {Annotation: fluxReconcileKey},
+	"ocirepositories.source.toolkit.fluxcd.io":       {Annotation: fluxReconcileKey},
+	"buckets.source.toolkit.fluxcd.io":               {Annotation: fluxReconcileKey},
+	"imagerepositories.image.toolkit.fluxcd.io":      {Annotation: fluxReconcileKey},
+	"imageupdateautomations.image.toolkit.fluxcd.io": {Annotation: fluxReconcileKey},
+	"applications.argoproj.io":                       {Annotation: "argocd.argoproj.io/refresh", Value: "normal"},
+}
+
+const fluxReconcileKey = "reconcile.fluxcd.io/requestedAt"
+
+// Validate checks a trigger targets either an annotation or a label.
+func (r Reconciler) Validate() error {
+	switch {
+	case r.Annotation == "" && r.Label == "":
+		return errors.New("reconciler must specify an annotation or a label")
+	case r.Annotation != "" && r.Label != "":
+		return errors.New("reconciler must specify either an annotation or a label")
+	default:
+		return nil
+	}
+}
+
+// Trigger returns the annotations and labels to patch to poke a resource.
+func (r Reconciler) Trigger(now time.Time) (annotations, labels map[string]string) {
+	v := r.Value
+	if r.Label != "" {
+		if v == "" {
+			v = strconv.FormatInt(now.Unix(), 10)
+		}
+		return nil, map[string]string{r.Label: v}
+	}
+	if v == "" {
+		v = now.UTC().Format(time.RFC3339Nano)
+	}
+
+	return map[string]string{r.Annotation: v}, nil
+}
+
+// For returns a reconciler for a given resource if any.
+// User defined triggers take precedence over the default ones.
+func (rr Reconcilers) For(gvr *client.GVR) (Reconciler, bool) {
+	for _, m := range []Reconcilers{rr, DefaultReconcilers} {
+		if r, ok := m[gvr.String()]; ok {
+			return r, true
+		}
+		if r, ok := m[gvr.GR().String()]; ok {
+			return r, true
+		}
+	}
+
+	return Reconciler{}, false
+}
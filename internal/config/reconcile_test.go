@@ -0,0 +1,105 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReconcilersFor(t *testing.T) {
+	rr := config.Reconcilers{
+		"acme.io/v1/gizmos":                        {Label: "acme.io/resync", Value: "true"},
+		"widgets.example.io":                       {Annotation: "example.io/reconcile-at"},
+		"gitrepositories.source.toolkit.fluxcd.io": {Annotation: "fred"},
+	}
+
+	uu := map[string]struct {
+		gvr *client.GVR
+		ok  bool
+		e   config.Reconciler
+	}{
+		"gvr": {
+			gvr: client.NewGVR("acme.io/v1/gizmos"),
+			ok:  true,
+			e:   config.Reconciler{Label: "acme.io/resync", Value: "true"},
+		},
+		"version-agnostic": {
+			gvr: client.NewGVR("example.io/v1beta1/widgets"),
+			ok:  true,
+			e:   config.Reconciler{Annotation: "example.io/reconcile-at"},
+		},
+		"default": {
+			gvr: client.NewGVR("kustomize.toolkit.fluxcd.io/v1/kustomizations"),
+			ok:  true,
+			e:   config.Reconciler{Annotation: "reconcile.fluxcd.io/requestedAt"},
+		},
+		"override": {
+			gvr: client.NewGVR("source.toolkit.fluxcd.io/v1/gitrepositories"),
+			ok:  true,
+			e:   config.Reconciler{Annotation: "fred"},
+		},
+		"unknown": {
+			gvr: client.NewGVR("v1/pods"),
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			r, ok := rr.For(u.gvr)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.e, r)
+		})
+	}
+}
+
+func TestReconcilerTrigger(t *testing.T) {
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	uu := map[string]struct {
+		r      config.Reconciler
+		err    bool
+		aa, ll map[string]string
+	}{
+		"annotation": {
+			r:  config.Reconciler{Annotation: "a"},
+			aa: map[string]string{"a": "2025-01-02T03:04:05Z"},
+		},
+		"label": {
+			r:  config.Reconciler{Label: "l", Value: "true"},
+			ll: map[string]string{"l": "true"},
+		},
+		"label-no-value": {
+			r:  config.Reconciler{Label: "l"},
+			ll: map[string]string{"l": "1735787045"},
+		},
+		"none": {
+			err: true,
+		},
+		"both": {
+			r:   config.Reconciler{Annotation: "a", Label: "l"},
+			err: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			err := u.r.Validate()
+			if u.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			aa, ll := u.r.Trigger(now)
+			assert.Equal(t, u.aa, aa)
+			assert.Equal(t, u.ll, ll)
+		})
+	}
+}
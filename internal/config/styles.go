@@ -127,6 +127,7 @@ type (
 		Xray   Xray   `json:"xray" yaml:"xray"`
 		Charts Charts `json:"charts" yaml:"charts"`
 		Yaml   Yaml   `json:"yaml" yaml:"yaml"`
+		Diff   Diff   `json:"diff" yaml:"diff"`
 		Picker Picker `json:"picker" yaml:"picker"`
 		Log    Log    `json:"logs" yaml:"logs"`
 	}
@@ -148,6 +149,16 @@ type (
 		FgColor   Color        `json:"fgColor" yaml:"fgColor"`
 		BgColor   Color        `json:"bgColor" yaml:"bgColor"`
 		Indicator LogIndicator `json:"indicator" yaml:"indicator"`
+		Levels    LogLevels    `json:"levels" yaml:"levels"`
+	}
+
+	// LogLevels tracks log lines colors by detected level.
+	LogLevels struct {
+		DebugColor Color `json:"debugColor" yaml:"debugColor"`
+		InfoColor  Color `json:"infoColor" yaml:"infoColor"`
+		WarnColor  Color `json:"warnColor" yaml:"warnColor"`
+		ErrorColor Color `json:"errorColor" yaml:"errorColor"`
+		FatalColor Color `json:"fatalColor" yaml:"fatalColor"`
 	}
 
 	// Picker tracks color when selecting containers
@@ -167,9 +178,17 @@ type (
 
 	// Yaml tracks yaml styles.
 	Yaml struct {
-		KeyColor   Color `json:"keyColor" yaml:"keyColor"`
-		ValueColor Color `json:"valueColor" yaml:"valueColor"`
-		ColonColor Color `json:"colonColor" yaml:"colonColor"`
+		KeyColor      Color `json:"keyColor" yaml:"keyColor"`
+		ValueColor    Color `json:"valueColor" yaml:"valueColor"`
+		ColonColor    Color `json:"colonColor" yaml:"colonColor"`
+		ChangeBgColor Color `json:"changeBgColor" yaml:"changeBgColor"`
+	}
+
+	// Diff tracks diff styles.
+	Diff struct {
+		AddColor    Color `json:"addColor" yaml:"addColor"`
+		DeleteColor Color `json:"deleteColor" yaml:"deleteColor"`
+		HunkColor   Color `json:"hunkColor" yaml:"hunkColor"`
 	}
 
 	// Title tracks title styles.
@@ -309,6 +328,7 @@ func newViews() Views {
 		Xray:   newXray(),
 		Charts: newCharts(),
 		Yaml:   newYaml(),
+		Diff:   newDiff(),
 		Picker: newPicker(),
 		Log:    newLog(),
 	}
@@ -372,6 +392,17 @@ func newLog() Log {
 		FgColor:   "lightskyblue",
 		BgColor:   "black",
 		Indicator: newLogIndicator(),
+		Levels:    newLogLevels(),
+	}
+}
+
+func newLogLevels() LogLevels {
+	return LogLevels{
+		DebugColor: "slategray",
+		InfoColor:  "lightskyblue",
+		WarnColor:  "orange",
+		ErrorColor: "orangered",
+		FatalColor: "red",
 	}
 }
 
@@ -386,9 +417,18 @@ func newLogIndicator() LogIndicator {
 
 func newYaml() Yaml {
 	return Yaml{
-		KeyColor:   "steelblue",
-		ColonColor: "white",
-		ValueColor: "papayawhip",
+		KeyColor:      "steelblue",
+		ColonColor:    "white",
+		ValueColor:    "papayawhip",
+		ChangeBgColor: "darkgreen",
+	}
+}
+
+func newDiff() Diff {
+	return Diff{
+		AddColor:    "green",
+		DeleteColor: "red",
+		HunkColor:   "aqua",
 	}
 }
 
@@ -47,6 +47,10 @@ type UI struct {
 	// UseFullGVRTitle toggles the display of full GVR (group/version/resource) vs R in views title.
 	UseFullGVRTitle bool `json:"useFullGVRTitle" yaml:"useFullGVRTitle"`
 
+	// CPUUnit specifies how cpu quantities are rendered (client.CPUCores|client.CPUMillicores).
+	// Leave blank to use each view defaults.
+	CPUUnit string `json:"cpuUnit" yaml:"cpuUnit,omitempty"`
+
 	manualHeadless   *bool
 	manualLogoless   *bool
 	manualCrumbsless *bool
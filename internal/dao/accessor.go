@@ -8,14 +8,16 @@ import (
 )
 
 var accessors = Accessors{
-	client.WkGVR:  new(Workload),
-	client.CtGVR:  new(Context),
-	client.CoGVR:  new(Container),
-	client.ScnGVR: new(ImageScan),
-	client.SdGVR:  new(ScreenDump),
-	client.BeGVR:  new(Benchmark),
-	client.PfGVR:  new(PortForward),
-	client.DirGVR: new(Dir),
+	client.WkGVR:   new(Workload),
+	client.CtGVR:   new(Context),
+	client.CoGVR:   new(Container),
+	client.ScnGVR:  new(ImageScan),
+	client.SdGVR:   new(ScreenDump),
+	client.BeGVR:   new(Benchmark),
+	client.WarnGVR: new(Warning),
+	client.FlGVR:   new(Fleet),
+	client.PfGVR:   new(PortForward),
+	client.DirGVR:  new(Dir),
 
 	client.SvcGVR:  new(Service),
 	client.PodGVR:  new(Pod),
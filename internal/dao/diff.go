@@ -0,0 +1,103 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/pmezard/go-difflib/difflib"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/cli-runtime/pkg/printers"
+)
+
+const lastAppliedKey = "kubectl.kubernetes.io/last-applied-configuration"
+
+// volatileMetaFields tracks server managed metadata that never appears in manifests.
+var volatileMetaFields = []string{
+	"managedFields",
+	"resourceVersion",
+	"uid",
+	"generation",
+	"creationTimestamp",
+	"selfLink",
+}
+
+// DiffManifest computes a unified diff between a live resource and its matching
+// document in a local manifest file.
+func DiffManifest(live *unstructured.Unstructured, path string) (string, error) {
+	oo, err := ReadManifests(path)
+	if err != nil {
+		return "", err
+	}
+	local, err := MatchManifest(oo, live)
+	if err != nil {
+		return "", fmt.Errorf("%w in %s", err, path)
+	}
+
+	return DiffResources(live, local, "live", path)
+}
+
+// MatchManifest returns the manifest document matching a given resource kind and name.
+func MatchManifest(oo []*unstructured.Unstructured, o *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	for _, m := range oo {
+		if m.GetKind() != o.GetKind() || m.GetName() != o.GetName() {
+			continue
+		}
+		if ns := m.GetNamespace(); ns != "" && ns != o.GetNamespace() {
+			continue
+		}
+		return m, nil
+	}
+
+	return nil, fmt.Errorf("no %s %q document found", o.GetKind(), o.GetName())
+}
+
+// DiffResources returns a unified diff of two normalized resources.
+func DiffResources(from, to *unstructured.Unstructured, fromLabel, toLabel string) (string, error) {
+	a, err := toNormalizedYAML(from)
+	if err != nil {
+		return "", err
+	}
+	b, err := toNormalizedYAML(to)
+	if err != nil {
+		return "", err
+	}
+
+	return unifiedDiff(a, b, fromLabel, toLabel)
+}
+
+// Normalize strips status and server managed fields off a resource.
+func Normalize(o *unstructured.Unstructured) *unstructured.Unstructured {
+	u := StripVolatile(o)
+	delete(u.Object, "status")
+
+	return u
+}
+
+func toNormalizedYAML(o *unstructured.Unstructured) (string, error) {
+	return toYAML(Normalize(o))
+}
+
+func toYAML(o *unstructured.Unstructured) (string, error) {
+	var (
+		buff bytes.Buffer
+		p    printers.YAMLPrinter
+	)
+	if err := p.PrintObj(o, &buff); err != nil {
+		return "", err
+	}
+
+	return buff.String(), nil
+}
+
+func unifiedDiff(a, b, fromLabel, toLabel string) (string, error) {
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(a),
+		B:        difflib.SplitLines(b),
+		FromFile: fromLabel,
+		ToFile:   toLabel,
+		Context:  3,
+	})
+}
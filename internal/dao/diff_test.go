@@ -0,0 +1,105 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestNormalize(t *testing.T) {
+	o := unstructured.Unstructured{Object: map[string]any{
+		"kind": "ConfigMap",
+		"metadata": map[string]any{
+			"name":            "cm1",
+			"uid":             "blee",
+			"resourceVersion": "10",
+			"managedFields":   []any{map[string]any{"manager": "fred"}},
+			"annotations": map[string]any{
+				"kubectl.kubernetes.io/last-applied-configuration": "{}",
+			},
+		},
+		"data":   map[string]any{"a": "1"},
+		"status": map[string]any{"fred": "blee"},
+	}}
+
+	u := dao.Normalize(&o)
+	assert.Equal(t, map[string]any{
+		"kind":     "ConfigMap",
+		"metadata": map[string]any{"name": "cm1"},
+		"data":     map[string]any{"a": "1"},
+	}, u.Object)
+	assert.Contains(t, o.Object, "status")
+}
+
+func TestMatchManifest(t *testing.T) {
+	oo, err := dao.DecodeManifests([]byte("kind: ConfigMap\nmetadata:\n  name: cm1\n---\nkind: Secret\nmetadata:\n  name: cm1\n  namespace: ns1\n---\nkind: Secret\nmetadata:\n  name: cm1\n"))
+	require.NoError(t, err)
+
+	uu := map[string]struct {
+		kind, ns, name string
+		idx            int
+		err            string
+	}{
+		"cm": {
+			kind: "ConfigMap", ns: "default", name: "cm1",
+		},
+		"sec-ns": {
+			kind: "Secret", ns: "ns1", name: "cm1", idx: 1,
+		},
+		"sec-no-ns": {
+			kind: "Secret", ns: "ns2", name: "cm1", idx: 2,
+		},
+		"none": {
+			kind: "Pod", ns: "default", name: "cm1",
+			err: `no Pod "cm1" document found`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var o unstructured.Unstructured
+			o.SetKind(u.kind)
+			o.SetNamespace(u.ns)
+			o.SetName(u.name)
+			m, err := dao.MatchManifest(oo, &o)
+			if u.err != "" {
+				require.EqualError(t, err, u.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Same(t, oo[u.idx], m)
+		})
+	}
+}
+
+func TestDiffManifest(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cm.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: cm1\ndata:\n  a: \"2\"\n"), 0o600))
+
+	live := unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata": map[string]any{
+			"name":            "cm1",
+			"namespace":       "default",
+			"resourceVersion": "10",
+		},
+		"data": map[string]any{"a": "1"},
+	}}
+
+	res, err := dao.DiffManifest(&live, path)
+	require.NoError(t, err)
+	assert.Contains(t, res, "--- live\n+++ "+path+"\n")
+	assert.Contains(t, res, "-  a: \"1\"\n+  a: \"2\"\n")
+	assert.Contains(t, res, "-  namespace: default\n")
+	assert.NotContains(t, res, "resourceVersion")
+}
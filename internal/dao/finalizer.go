@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/derailed/k9s/internal/client"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// RemoveFinalizer removes a finalizer from a resource given its current finalizers.
+// The patch fails if the finalizers changed in the meantime.
+func RemoveFinalizer(ctx context.Context, f Factory, gvr *client.GVR, path string, ff []string, finalizer string) error {
+	ns, n := client.Namespaced(path)
+	auth, err := f.Client().CanI(ns, gvr, n, client.PatchAccess)
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to patch %s", path)
+	}
+	patch, err := FinalizerPatch(ff, finalizer)
+	if err != nil {
+		return err
+	}
+
+	return patchResource(ctx, f, gvr, path, types.JSONPatchType, patch)
+}
+
+// FinalizerPatch returns a json patch removing a finalizer, guarded by a test operation.
+func FinalizerPatch(ff []string, finalizer string) ([]byte, error) {
+	idx := slices.Index(ff, finalizer)
+	if idx < 0 {
+		return nil, fmt.Errorf("finalizer %q not found", finalizer)
+	}
+	p := fmt.Sprintf("/metadata/finalizers/%d", idx)
+
+	return json.Marshal([]map[string]any{
+		{"op": "test", "path": p, "value": finalizer},
+		{"op": "remove", "path": p},
+	})
+}
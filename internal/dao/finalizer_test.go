@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFinalizerPatch(t *testing.T) {
+	uu := map[string]struct {
+		ff  []string
+		f   string
+		err bool
+		e   string
+	}{
+		"missing": {
+			ff:  []string{"a"},
+			f:   "b",
+			err: true,
+		},
+		"first": {
+			ff: []string{"a", "b"},
+			f:  "a",
+			e:  `[{"op":"test","path":"/metadata/finalizers/0","value":"a"},{"op":"remove","path":"/metadata/finalizers/0"}]`,
+		},
+		"last": {
+			ff: []string{"a", "kubernetes.io/pvc-protection"},
+			f:  "kubernetes.io/pvc-protection",
+			e:  `[{"op":"test","path":"/metadata/finalizers/1","value":"kubernetes.io/pvc-protection"},{"op":"remove","path":"/metadata/finalizers/1"}]`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			bb, err := dao.FinalizerPatch(u.ff, u.f)
+			if u.err {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.JSONEq(t, u.e, string(bb))
+		})
+	}
+}
@@ -0,0 +1,128 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic"
+)
+
+// maxFleetCallTimeout caps per context calls so a down cluster does not stall the view.
+const maxFleetCallTimeout = 10 * time.Second
+
+var _ Accessor = (*Fleet)(nil)
+
+// FleetSpec tracks a resource to aggregate across contexts.
+type FleetSpec struct {
+	GVR      *client.GVR
+	Contexts []string
+}
+
+// Fleet represents a resource aggregated across several contexts.
+type Fleet struct {
+	NonResource
+
+	dials map[string]dynamic.Interface
+	dmx   sync.Mutex
+}
+
+// List returns a collection of resources across contexts in the active namespace.
+// Failing contexts are reported as error entries.
+func (f *Fleet) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	spec, ok := ctx.Value(internal.KeyFleet).(FleetSpec)
+	if !ok {
+		return nil, fmt.Errorf("expecting a fleet spec but got %T", ctx.Value(internal.KeyFleet))
+	}
+	if len(spec.Contexts) == 0 {
+		return nil, errors.New("no fleet contexts configured. Check your k9s config fleet.contexts")
+	}
+	ns, _ := ctx.Value(internal.KeyNamespace).(string)
+	if ok, err := MetaAccess.IsNamespaced(spec.GVR); err != nil || !ok {
+		ns = client.BlankNamespace
+	}
+	sel := labels.Everything()
+	if s, ok := ctx.Value(internal.KeyLabels).(labels.Selector); ok && s != nil {
+		sel = s
+	}
+
+	results := make([][]runtime.Object, len(spec.Contexts))
+	var wg sync.WaitGroup
+	for i, ct := range spec.Contexts {
+		wg.Add(1)
+		go func(i int, ct string) {
+			defer wg.Done()
+			results[i] = f.listContext(ctx, ct, spec.GVR, ns, sel)
+		}(i, ct)
+	}
+	wg.Wait()
+
+	oo := make([]runtime.Object, 0, len(results))
+	for _, rr := range results {
+		oo = append(oo, rr...)
+	}
+
+	return oo, nil
+}
+
+func (f *Fleet) listContext(ctx context.Context, ct string, gvr *client.GVR, ns string, sel labels.Selector) []runtime.Object {
+	dial, err := f.dial(ct)
+	if err != nil {
+		return []runtime.Object{render.FleetRes{Context: ct, Err: err}}
+	}
+	ctx, cancel := context.WithTimeout(ctx, min(f.Client().Config().CallTimeout(), maxFleetCallTimeout))
+	defer cancel()
+
+	ll, err := dial.Resource(gvr.GVR()).Namespace(client.CleanseNamespace(ns)).List(ctx, metav1.ListOptions{
+		LabelSelector: sel.String(),
+	})
+	if err != nil {
+		return []runtime.Object{render.FleetRes{Context: ct, Err: err}}
+	}
+	oo := make([]runtime.Object, 0, len(ll.Items))
+	for i := range ll.Items {
+		st, msg := Readiness(&ll.Items[i])
+		oo = append(oo, render.FleetRes{
+			Context: ct,
+			Object:  &ll.Items[i],
+			Status:  st.String(),
+			Message: msg,
+		})
+	}
+
+	return oo
+}
+
+func (f *Fleet) dial(ct string) (dynamic.Interface, error) {
+	f.dmx.Lock()
+	defer f.dmx.Unlock()
+
+	if d, ok := f.dials[ct]; ok {
+		return d, nil
+	}
+	cfg, err := f.Client().Config().ContextRESTConfig(ct)
+	if err != nil {
+		return nil, err
+	}
+	d, err := dynamic.NewForConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if f.dials == nil {
+		f.dials = make(map[string]dynamic.Interface)
+	}
+	f.dials[ct] = d
+
+	return d, nil
+}
@@ -0,0 +1,37 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"bytes"
+	"fmt"
+	"text/tabwriter"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// Identity returns a formatted block uniquely identifying a resource across clusters.
+// Namespace is omitted for cluster scoped resources.
+func Identity(o *unstructured.Unstructured, context string) string {
+	var buff bytes.Buffer
+	w := tabwriter.NewWriter(&buff, 0, 0, 1, ' ', 0)
+	row := func(k, v string) {
+		_, _ = fmt.Fprintf(w, "%s:\t%s\n", k, v)
+	}
+	row("context", context)
+	row("kind", o.GetKind())
+	row("apiVersion", o.GetAPIVersion())
+	if ns := o.GetNamespace(); ns != "" {
+		row("namespace", ns)
+	}
+	row("name", o.GetName())
+	row("uid", string(o.GetUID()))
+	if t := o.GetCreationTimestamp(); !t.IsZero() {
+		row("created", t.UTC().Format(time.RFC3339))
+	}
+	_ = w.Flush()
+
+	return buff.String()
+}
@@ -0,0 +1,63 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestIdentity(t *testing.T) {
+	uu := map[string]struct {
+		o *unstructured.Unstructured
+		e string
+	}{
+		"namespaced": {
+			o: &unstructured.Unstructured{Object: map[string]any{
+				"apiVersion": "v1",
+				"kind":       "Pod",
+				"metadata": map[string]any{
+					"namespace":         "default",
+					"name":              "fred",
+					"uid":               "1234",
+					"creationTimestamp": "2024-01-02T03:04:05Z",
+				},
+			}},
+			e: `context:    ctx1
+kind:       Pod
+apiVersion: v1
+namespace:  default
+name:       fred
+uid:        1234
+created:    2024-01-02T03:04:05Z
+`,
+		},
+		"cluster": {
+			o: &unstructured.Unstructured{Object: map[string]any{
+				"apiVersion": "rbac.authorization.k8s.io/v1",
+				"kind":       "ClusterRole",
+				"metadata": map[string]any{
+					"name": "admin",
+					"uid":  "5678",
+				},
+			}},
+			e: `context:    ctx1
+kind:       ClusterRole
+apiVersion: rbac.authorization.k8s.io/v1
+name:       admin
+uid:        5678
+`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, dao.Identity(u.o, "ctx1"))
+		})
+	}
+}
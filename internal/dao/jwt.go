@@ -0,0 +1,130 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/duration"
+)
+
+// ErrNotJWT signals a token is not a JWT.
+var ErrNotJWT = errors.New("not a JWT")
+
+// JWT represents a decoded but unverified json web token.
+type JWT struct {
+	Header map[string]any
+	Claims map[string]any
+}
+
+// JWTSummary represents a human readable JWT digest.
+type JWTSummary struct {
+	Status    string         `yaml:"status"`
+	Subject   string         `yaml:"subject,omitempty"`
+	Issuer    string         `yaml:"issuer,omitempty"`
+	Audiences []string       `yaml:"audiences,omitempty"`
+	IssuedAt  string         `yaml:"issuedAt,omitempty"`
+	ExpiresAt string         `yaml:"expiresAt,omitempty"`
+	Header    map[string]any `yaml:"header"`
+	Claims    map[string]any `yaml:"claims"`
+}
+
+// ParseJWT decodes a JWT header and claims. The signature is not verified!
+func ParseJWT(token string) (*JWT, error) {
+	tokens := strings.Split(strings.TrimSpace(token), ".")
+	if len(tokens) != 3 {
+		return nil, ErrNotJWT
+	}
+
+	var jwt JWT
+	if err := decodeJWTSegment(tokens[0], &jwt.Header); err != nil {
+		return nil, fmt.Errorf("%w: invalid header: %w", ErrNotJWT, err)
+	}
+	if err := decodeJWTSegment(tokens[1], &jwt.Claims); err != nil {
+		return nil, fmt.Errorf("%w: invalid claims: %w", ErrNotJWT, err)
+	}
+
+	return &jwt, nil
+}
+
+// ExpiresAt returns the token expiration time if any.
+func (j *JWT) ExpiresAt() (time.Time, bool) {
+	return j.timeClaim("exp")
+}
+
+// IssuedAt returns the token issue time if any.
+func (j *JWT) IssuedAt() (time.Time, bool) {
+	return j.timeClaim("iat")
+}
+
+// Expired checks if the token has expired at a given time.
+func (j *JWT) Expired(now time.Time) bool {
+	exp, ok := j.ExpiresAt()
+
+	return ok && !now.Before(exp)
+}
+
+// Audiences returns the token audiences.
+func (j *JWT) Audiences() []string {
+	switch aud := j.Claims["aud"].(type) {
+	case string:
+		return []string{aud}
+	case []any:
+		ss := make([]string, 0, len(aud))
+		for _, a := range aud {
+			ss = append(ss, fmt.Sprintf("%v", a))
+		}
+		return ss
+	default:
+		return nil
+	}
+}
+
+// Summary returns a token digest flagging expired tokens at a given time.
+func (j *JWT) Summary(now time.Time) JWTSummary {
+	sum := JWTSummary{
+		Status:    "valid (no expiration)",
+		Audiences: j.Audiences(),
+		Header:    j.Header,
+		Claims:    j.Claims,
+	}
+	sum.Subject, _ = j.Claims["sub"].(string)
+	sum.Issuer, _ = j.Claims["iss"].(string)
+	if iat, ok := j.IssuedAt(); ok {
+		sum.IssuedAt = iat.UTC().Format(time.RFC3339)
+	}
+	if exp, ok := j.ExpiresAt(); ok {
+		sum.ExpiresAt = exp.UTC().Format(time.RFC3339)
+		if j.Expired(now) {
+			sum.Status = fmt.Sprintf("EXPIRED (%s ago)", duration.HumanDuration(now.Sub(exp)))
+		} else {
+			sum.Status = fmt.Sprintf("valid (expires in %s)", duration.HumanDuration(exp.Sub(now)))
+		}
+	}
+
+	return sum
+}
+
+func (j *JWT) timeClaim(k string) (time.Time, bool) {
+	v, ok := j.Claims[k].(float64)
+	if !ok {
+		return time.Time{}, false
+	}
+
+	return time.Unix(int64(v), 0), true
+}
+
+func decodeJWTSegment(s string, v any) error {
+	bb, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(s, "="))
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(bb, v)
+}
@@ -0,0 +1,77 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao_test
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseJWT(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+
+	uu := map[string]struct {
+		token   string
+		err     error
+		status  string
+		sub     string
+		aud     []string
+		expired bool
+	}{
+		"valid": {
+			token:  testJWT(`{"sub":"system:serviceaccount:default:fred","iss":"https://kubernetes.default.svc","aud":["api","vault"],"exp":1700003600}`),
+			status: "valid (expires in 60m)",
+			sub:    "system:serviceaccount:default:fred",
+			aud:    []string{"api", "vault"},
+		},
+		"expired": {
+			token:   testJWT(`{"sub":"fred","aud":"api","exp":1699992800}`),
+			status:  "EXPIRED (120m ago)",
+			sub:     "fred",
+			aud:     []string{"api"},
+			expired: true,
+		},
+		"no-exp": {
+			token:  testJWT(`{"sub":"fred"}`),
+			status: "valid (no expiration)",
+			sub:    "fred",
+		},
+		"not-jwt": {
+			token: "blee",
+			err:   dao.ErrNotJWT,
+		},
+		"bad-claims": {
+			token: testJWT(`{"sub":`),
+			err:   dao.ErrNotJWT,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			jwt, err := dao.ParseJWT(u.token)
+			if u.err != nil {
+				require.ErrorIs(t, err, u.err)
+				return
+			}
+			require.NoError(t, err)
+			sum := jwt.Summary(now)
+			assert.Equal(t, u.status, sum.Status)
+			assert.Equal(t, u.sub, sum.Subject)
+			assert.Equal(t, u.aud, sum.Audiences)
+			assert.Equal(t, u.expired, jwt.Expired(now))
+		})
+	}
+}
+
+func testJWT(claims string) string {
+	enc := base64.RawURLEncoding
+
+	return enc.EncodeToString([]byte(`{"alg":"RS256","kid":"k1"}`)) + "." + enc.EncodeToString([]byte(claims)) + ".c2ln"
+}
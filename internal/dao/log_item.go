@@ -5,6 +5,8 @@ package dao
 
 import (
 	"bytes"
+	"regexp"
+	"time"
 )
 
 // LogChan represents a channel for logs.
@@ -51,6 +53,24 @@ func (l *LogItem) GetTimestamp() string {
 	return string(l.Bytes[:index])
 }
 
+// Time returns the log line timestamp if any.
+func (l *LogItem) Time() (time.Time, bool) {
+	t, err := time.Parse(time.RFC3339Nano, l.GetTimestamp())
+
+	return t, err == nil
+}
+
+// Plain returns a log line as displayed minus colors and escapes.
+func (l *LogItem) Plain(showTime bool) string {
+	bb := bytes.NewBuffer(make([]byte, 0, l.Size()))
+	l.layout("", "", showTime, false, bb)
+
+	return escapedTagRX.ReplaceAllString(bb.String(), "[$1$2]")
+}
+
+// escapedTagRX matches tview escaped tags.
+var escapedTagRX = regexp.MustCompile(`\[([a-zA-Z0-9_,;: \-\."#]+)\[(\[*)\]`)
+
 // Info returns pod and container information.
 func (l *LogItem) Info() string {
 	return l.Pod + "::" + l.Container
@@ -68,33 +88,63 @@ func (l *LogItem) Size() int {
 
 // Render returns a log line as string.
 func (l *LogItem) Render(paint string, showTime bool, bb *bytes.Buffer) {
+	l.RenderTinted(paint, "", showTime, bb)
+}
+
+// RenderTinted returns a log line as string with its message painted in a given color.
+func (l *LogItem) RenderTinted(paint, tint string, showTime bool, bb *bytes.Buffer) {
+	l.layout(paint, tint, showTime, true, bb)
+}
+
+// timestampWidth pads timestamps so log messages line up.
+const timestampWidth = 30
+
+// layout writes out a log line with or without color tags.
+func (l *LogItem) layout(paint, tint string, showTime, colors bool, bb *bytes.Buffer) {
+	tag := func(t string) {
+		if colors {
+			bb.WriteString(t)
+		}
+	}
+
 	index := bytes.Index(l.Bytes, []byte{' '})
 	if showTime && index > 0 {
-		bb.WriteString("[gray::b]")
+		tag("[gray::b]")
 		bb.Write(l.Bytes[:index])
 		bb.WriteString(" ")
-		if l := 30 - len(l.Bytes[:index]); l > 0 {
+		if l := timestampWidth - len(l.Bytes[:index]); l > 0 {
 			bb.Write(bytes.Repeat([]byte{' '}, l))
 		}
-		bb.WriteString("[-::-]")
+		tag("[-::-]")
 	}
 
 	if l.Pod != "" {
-		bb.WriteString("[" + paint + "::]" + l.Pod)
+		tag("[" + paint + "::]")
+		bb.WriteString(l.Pod)
 	}
 
 	if !l.SingleContainer && l.Container != "" {
 		if l.Pod != "" {
 			bb.WriteString(" ")
 		}
-		bb.WriteString("[" + paint + "::b]" + l.Container + "[-::-] ")
+		tag("[" + paint + "::b]")
+		bb.WriteString(l.Container)
+		tag("[-::-]")
+		bb.WriteString(" ")
 	} else if l.Pod != "" {
-		bb.WriteString("[-::] ")
+		tag("[-::]")
+		bb.WriteString(" ")
 	}
 
+	if tint != "" {
+		tag("[" + tint + "::]")
+	}
 	if index > 0 {
 		bb.Write(l.Bytes[index+1:])
 	} else {
 		bb.Write(l.Bytes)
 	}
+	if tint != "" {
+		tag("[-::]")
+	}
 }
@@ -105,6 +105,50 @@ func TestLogItemRender(t *testing.T) {
 	}
 }
 
+func TestLogItemPlain(t *testing.T) {
+	uu := map[string]struct {
+		opts dao.LogOptions
+		log  string
+		e    string
+	}{
+		"plain": {
+			log: "2018-12-14T10:36:43.326972-07:00 Testing 1,2,3...",
+			e:   "Testing 1,2,3...",
+		},
+		"full": {
+			opts: dao.LogOptions{
+				Path:            "blee/fred",
+				Container:       "blee",
+				SingleContainer: true,
+				ShowTimestamp:   true,
+			},
+			log: "2018-12-14T10:36:43.326972-07:00 Testing 1,2,3...",
+			e:   "2018-12-14T10:36:43.326972-07:00 fred Testing 1,2,3...",
+		},
+		"escape": {
+			opts: dao.LogOptions{
+				Path: "blee/fred",
+			},
+			log: `2018-12-14T10:36:43.326972-07:00 {"foo":["bar"]} Server listening on: [::]:5000`,
+			e:   `fred {"foo":["bar"]} Server listening on: [::]:5000`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			i := dao.NewLogItem([]byte(tview.Escape(u.log)))
+			_, n := client.Namespaced(u.opts.Path)
+			i.Pod, i.Container, i.SingleContainer = n, u.opts.Container, u.opts.SingleContainer
+
+			assert.Equal(t, u.e, i.Plain(u.opts.ShowTimestamp))
+			ts, ok := i.Time()
+			assert.True(t, ok)
+			assert.Equal(t, 2018, ts.Year())
+		})
+	}
+}
+
 func BenchmarkLogItemRenderTS(b *testing.B) {
 	s := []byte(fmt.Sprintf("%s %s\n", "2018-12-14T10:36:43.326972-07:00", "Testing 1,2,3..."))
 	i := dao.NewLogItem(s)
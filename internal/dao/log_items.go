@@ -31,6 +31,7 @@ var podPalette = []string{
 type LogItems struct {
 	items     []*LogItem
 	podColors podColors
+	levels    *LogLevels
 	mx        sync.RWMutex
 }
 
@@ -84,6 +85,7 @@ func (l *LogItems) Subset(index int) *LogItems {
 	return &LogItems{
 		items:     l.items[index:],
 		podColors: l.podColors,
+		levels:    l.levels,
 	}
 }
 
@@ -98,6 +100,14 @@ func (l *LogItems) Merge(n *LogItems) {
 	}
 }
 
+// SetLevels sets a log levels classifier to color lines by severity or nil to turn coloring off.
+func (l *LogItems) SetLevels(lv *LogLevels) {
+	l.mx.Lock()
+	defer l.mx.Unlock()
+
+	l.levels = lv
+}
+
 // Add augments the items.
 func (l *LogItems) Add(ii ...*LogItem) {
 	l.mx.Lock()
@@ -149,9 +159,12 @@ func (l *LogItems) StrLines(index int, showTime bool) []string {
 
 // Render returns logs as a collection of strings.
 func (l *LogItems) Render(index int, showTime bool, ll [][]byte) {
+	l.mx.Lock()
+	defer l.mx.Unlock()
+
 	for i, item := range l.items[index:] {
 		bb := bytes.NewBuffer(make([]byte, 0, item.Size()))
-		item.Render(l.podColorFor(item.ID()), showTime, bb)
+		item.RenderTinted(l.podColorFor(item.ID()), l.levels.Color(logMessage(item.Bytes)), showTime, bb)
 		ll[i] = bb.Bytes()
 	}
 }
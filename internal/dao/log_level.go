@@ -0,0 +1,121 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+)
+
+// levelScanSize caps how much of a log line is scanned for a level.
+const levelScanSize = 256
+
+// LogLevel represents a log line severity.
+type LogLevel int
+
+const (
+	// LevelUnknown tracks lines without a recognizable level.
+	LevelUnknown LogLevel = iota
+
+	// LevelDebug tracks debug or trace lines.
+	LevelDebug
+
+	// LevelInfo tracks informational lines.
+	LevelInfo
+
+	// LevelWarn tracks warning lines.
+	LevelWarn
+
+	// LevelError tracks error lines.
+	LevelError
+
+	// LevelFatal tracks fatal, panic or critical lines.
+	LevelFatal
+)
+
+// LevelNames tracks log level configuration names.
+var LevelNames = map[string]LogLevel{
+	"debug": LevelDebug,
+	"info":  LevelInfo,
+	"warn":  LevelWarn,
+	"error": LevelError,
+	"fatal": LevelFatal,
+}
+
+// DefaultLevelPatterns tracks level detection patterns for plain text, logfmt, json and klog formats.
+var DefaultLevelPatterns = map[LogLevel]string{
+	LevelFatal: `\b(FATAL|PANIC|CRIT|CRITICAL)\b|(?i:level"?\s*[=:]\s*"?(fatal|panic|crit|critical)\b)|^F\d{4} `,
+	LevelError: `\b(ERROR|ERR)\b|(?i:level"?\s*[=:]\s*"?(error|err)\b)|^E\d{4} `,
+	LevelWarn:  `\b(WARN|WARNING)\b|(?i:level"?\s*[=:]\s*"?(warn|warning)\b)|^W\d{4} `,
+	LevelInfo:  `\bINFO\b|(?i:level"?\s*[=:]\s*"?info\b)|^I\d{4} `,
+	LevelDebug: `\b(DEBUG|TRACE)\b|(?i:level"?\s*[=:]\s*"?(debug|trace)\b)|^D\d{4} `,
+}
+
+// levelOrder tracks levels evaluation order, most severe first.
+var levelOrder = []LogLevel{LevelFatal, LevelError, LevelWarn, LevelInfo, LevelDebug}
+
+// LogLevels classifies log lines by severity.
+type LogLevels struct {
+	rxs    map[LogLevel]*regexp.Regexp
+	colors map[LogLevel]string
+}
+
+// NewLogLevels returns a new classifier. Custom patterns keyed by level name override the defaults.
+func NewLogLevels(patterns map[string]string, colors map[LogLevel]string) (*LogLevels, error) {
+	pp := make(map[LogLevel]string, len(DefaultLevelPatterns))
+	for l, p := range DefaultLevelPatterns {
+		pp[l] = p
+	}
+	for n, p := range patterns {
+		l, ok := LevelNames[n]
+		if !ok {
+			return nil, fmt.Errorf("unknown log level %q", n)
+		}
+		pp[l] = p
+	}
+
+	rxs := make(map[LogLevel]*regexp.Regexp, len(pp))
+	for l, p := range pp {
+		rx, err := regexp.Compile(p)
+		if err != nil {
+			return nil, fmt.Errorf("invalid log level pattern %q: %w", p, err)
+		}
+		rxs[l] = rx
+	}
+
+	return &LogLevels{rxs: rxs, colors: colors}, nil
+}
+
+// Classify returns a log line severity.
+func (l *LogLevels) Classify(bb []byte) LogLevel {
+	if len(bb) > levelScanSize {
+		bb = bb[:levelScanSize]
+	}
+	for _, lvl := range levelOrder {
+		if rx, ok := l.rxs[lvl]; ok && rx.Match(bb) {
+			return lvl
+		}
+	}
+
+	return LevelUnknown
+}
+
+// Color returns a log line color based on its severity or blank if unknown.
+func (l *LogLevels) Color(bb []byte) string {
+	if l == nil {
+		return ""
+	}
+
+	return l.colors[l.Classify(bb)]
+}
+
+// logMessage strips the leading timestamp off a log line.
+func logMessage(bb []byte) []byte {
+	if index := bytes.Index(bb, []byte{' '}); index > 0 {
+		return bb[index+1:]
+	}
+
+	return bb
+}
@@ -0,0 +1,127 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogLevelsClassify(t *testing.T) {
+	lv, err := dao.NewLogLevels(nil, nil)
+	require.NoError(t, err)
+
+	uu := map[string]struct {
+		l string
+		e dao.LogLevel
+	}{
+		"plain-error": {
+			l: "2024-01-01 12:00:00 ERROR boom",
+			e: dao.LevelError,
+		},
+		"bracket-warn": {
+			l: "[WARN] disk almost full",
+			e: dao.LevelWarn,
+		},
+		"logfmt-info": {
+			l: `time=2024-01-01T00:00:00Z level=info msg="started"`,
+			e: dao.LevelInfo,
+		},
+		"json-debug": {
+			l: `{"level":"debug","msg":"hello"}`,
+			e: dao.LevelDebug,
+		},
+		"json-spaced-fatal": {
+			l: `{"level": "FATAL", "msg": "bye"}`,
+			e: dao.LevelFatal,
+		},
+		"klog-error": {
+			l: "E0102 15:04:05.000000       1 main.go:12] boom",
+			e: dao.LevelError,
+		},
+		"panic": {
+			l: "PANIC: nil pointer",
+			e: dao.LevelFatal,
+		},
+		"most-severe": {
+			l: "INFO retrying after ERROR",
+			e: dao.LevelError,
+		},
+		"lowercase-prose": {
+			l: "no error found in info section",
+			e: dao.LevelUnknown,
+		},
+		"unknown": {
+			l: "hello world",
+			e: dao.LevelUnknown,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, lv.Classify([]byte(u.l)))
+		})
+	}
+}
+
+func TestNewLogLevels(t *testing.T) {
+	uu := map[string]struct {
+		pp  map[string]string
+		l   string
+		e   dao.LogLevel
+		err string
+	}{
+		"override": {
+			pp: map[string]string{"warn": `^W: `},
+			l:  "W: careful",
+			e:  dao.LevelWarn,
+		},
+		"unknown-level": {
+			pp:  map[string]string{"blee": "x"},
+			err: `unknown log level "blee"`,
+		},
+		"bad-rx": {
+			pp:  map[string]string{"info": "("},
+			err: "invalid log level pattern \"(\": error parsing regexp: missing closing ): `(`",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			lv, err := dao.NewLogLevels(u.pp, nil)
+			if u.err != "" {
+				assert.EqualError(t, err, u.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, u.e, lv.Classify([]byte(u.l)))
+		})
+	}
+}
+
+func TestLogItemsRenderLevels(t *testing.T) {
+	lv, err := dao.NewLogLevels(nil, map[dao.LogLevel]string{dao.LevelError: "red"})
+	require.NoError(t, err)
+
+	ii := dao.NewLogItems()
+	ii.Add(
+		dao.NewLogItemFromString("2024-01-01T00:00:00Z ERROR boom"),
+		dao.NewLogItemFromString("2024-01-01T00:00:00Z INFO ok"),
+	)
+	ii.SetLevels(lv)
+	ll := make([][]byte, ii.Len())
+	ii.Render(0, false, ll)
+
+	assert.Equal(t, "[red::]ERROR boom[-::]", string(ll[0]))
+	assert.Equal(t, "INFO ok", string(ll[1]))
+
+	ii.SetLevels(nil)
+	ii.Render(0, false, ll)
+	assert.Equal(t, "ERROR boom", string(ll[0]))
+}
@@ -20,8 +20,10 @@ type LogOptions struct {
 	DefaultContainer string
 	SinceTime        string
 	Lines            int64
+	SnapshotLines    int64
 	SinceSeconds     int64
 	Head             bool
+	Snapshot         bool
 	Previous         bool
 	SingleContainer  bool
 	MultiPods        bool
@@ -45,8 +47,10 @@ func (o *LogOptions) Clone() *LogOptions {
 		Container:        o.Container,
 		DefaultContainer: o.DefaultContainer,
 		Lines:            o.Lines,
+		SnapshotLines:    o.SnapshotLines,
 		Previous:         o.Previous,
 		Head:             o.Head,
+		Snapshot:         o.Snapshot,
 		SingleContainer:  o.SingleContainer,
 		MultiPods:        o.MultiPods,
 		ShowTimestamp:    o.ShowTimestamp,
@@ -93,6 +97,11 @@ func (o *LogOptions) ToPodLogOptions() *v1.PodLogOptions {
 		opts.LimitBytes = &maxBytes
 		return &opts
 	}
+	if o.Snapshot {
+		opts.Follow = false
+		opts.TailLines, opts.SinceSeconds, opts.SinceTime = &o.SnapshotLines, nil, nil
+		return &opts
+	}
 	if o.SinceSeconds < 0 {
 		return &opts
 	}
@@ -44,3 +44,33 @@ func TestLogOptionsToggleAllContainers(t *testing.T) {
 		})
 	}
 }
+
+func TestLogOptionsToPodLogOptions(t *testing.T) {
+	uu := map[string]struct {
+		opts   dao.LogOptions
+		follow bool
+		tail   int64
+	}{
+		"follow": {
+			opts:   dao.LogOptions{Lines: 100, SnapshotLines: 500, SinceSeconds: -1},
+			follow: true,
+			tail:   100,
+		},
+		"snapshot": {
+			opts: dao.LogOptions{Lines: 100, SnapshotLines: 500, SinceSeconds: 300, Snapshot: true},
+			tail: 500,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			o := u.opts.ToPodLogOptions()
+			assert.Equal(t, u.follow, o.Follow)
+			assert.Equal(t, u.tail, *o.TailLines)
+			if u.opts.Snapshot {
+				assert.Nil(t, o.SinceSeconds)
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/yaml"
+)
+
+const manifestBuffSize = 4096
+
+// ReadManifests loads all resources declared in a given manifest file.
+func ReadManifests(path string) ([]*unstructured.Unstructured, error) {
+	bb, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return DecodeManifests(bb)
+}
+
+// DecodeManifests decodes a possibly multi-document YAML or JSON manifest.
+func DecodeManifests(bb []byte) ([]*unstructured.Unstructured, error) {
+	var (
+		dec = yaml.NewYAMLOrJSONDecoder(bytes.NewReader(bb), manifestBuffSize)
+		oo  []*unstructured.Unstructured
+	)
+	for {
+		var m map[string]any
+		if err := dec.Decode(&m); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, err
+		}
+		if len(m) == 0 {
+			continue
+		}
+		u := unstructured.Unstructured{Object: m}
+		if u.IsList() {
+			l, err := u.ToList()
+			if err != nil {
+				return nil, err
+			}
+			for i := range l.Items {
+				oo = append(oo, &l.Items[i])
+			}
+			continue
+		}
+		if u.GetKind() == "" || u.GetName() == "" {
+			return nil, fmt.Errorf("manifest document #%d is missing a kind or name", len(oo)+1)
+		}
+		oo = append(oo, &u)
+	}
+	if len(oo) == 0 {
+		return nil, errors.New("no resources found in manifest")
+	}
+
+	return oo, nil
+}
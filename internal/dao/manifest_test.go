@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeManifests(t *testing.T) {
+	uu := map[string]struct {
+		raw   string
+		names []string
+		err   string
+	}{
+		"single": {
+			raw:   "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: cm1\n",
+			names: []string{"cm1"},
+		},
+		"multi": {
+			raw:   "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: cm1\n---\n---\napiVersion: v1\nkind: Secret\nmetadata:\n  name: s1\n",
+			names: []string{"cm1", "s1"},
+		},
+		"list": {
+			raw:   "apiVersion: v1\nkind: List\nitems:\n- apiVersion: v1\n  kind: ConfigMap\n  metadata:\n    name: cm1\n- apiVersion: v1\n  kind: ConfigMap\n  metadata:\n    name: cm2\n",
+			names: []string{"cm1", "cm2"},
+		},
+		"json": {
+			raw:   `{"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"name": "cm1"}}`,
+			names: []string{"cm1"},
+		},
+		"no-name": {
+			raw: "apiVersion: v1\nkind: ConfigMap\n",
+			err: "manifest document #1 is missing a kind or name",
+		},
+		"empty": {
+			raw: "---\n",
+			err: "no resources found in manifest",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			oo, err := dao.DecodeManifests([]byte(u.raw))
+			if u.err != "" {
+				require.EqualError(t, err, u.err)
+				return
+			}
+			require.NoError(t, err)
+			names := make([]string, 0, len(oo))
+			for _, o := range oo {
+				names = append(names, o.GetName())
+			}
+			assert.Equal(t, u.names, names)
+		})
+	}
+}
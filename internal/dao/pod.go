@@ -10,6 +10,8 @@ import (
 	"fmt"
 	"io"
 	"log/slog"
+	"slices"
+	"strings"
 	"sync"
 	"time"
 
@@ -504,7 +506,9 @@ func readLogs(ctx context.Context, stream io.ReadCloser, out chan<- *LogItem, op
 				out <- opts.ToLogItem(tview.EscapeBytes(bytes))
 			}
 			slog.Debug("Log reader reached EOF", slogs.Container, opts.Info())
-			out <- opts.ToErrLogItem(fmt.Errorf("stream closed: %w for %s", err, opts.Info()))
+			if !opts.Snapshot {
+				out <- opts.ToErrLogItem(fmt.Errorf("stream closed: %w for %s", err, opts.Info()))
+			}
 			return streamEOF
 		}
 
@@ -636,3 +640,44 @@ func (p *Pod) Sanitize(ctx context.Context, ns string) (int, error) {
 
 	return count, nil
 }
+
+// IsCrashLooping checks if any of the pod containers is backing off from a crash.
+func IsCrashLooping(po *v1.Pod) bool {
+	for _, ss := range [][]v1.ContainerStatus{po.Status.InitContainerStatuses, po.Status.ContainerStatuses} {
+		for _, cs := range ss {
+			if w := cs.State.Waiting; w != nil && w.Reason == render.PhaseCrashLoop {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// CrashLooping returns all crashlooping pods in a given namespace.
+func (p *Pod) CrashLooping(ctx context.Context, ns string) ([]*v1.Pod, error) {
+	oo, err := p.Resource.List(ctx, ns)
+	if err != nil {
+		return nil, err
+	}
+
+	pp := make([]*v1.Pod, 0, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			continue
+		}
+		var pod v1.Pod
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &pod); err != nil {
+			continue
+		}
+		if pod.DeletionTimestamp == nil && IsCrashLooping(&pod) {
+			pp = append(pp, &pod)
+		}
+	}
+	slices.SortFunc(pp, func(a, b *v1.Pod) int {
+		return strings.Compare(client.FQN(a.Namespace, a.Name), client.FQN(b.Namespace, b.Name))
+	})
+
+	return pp, nil
+}
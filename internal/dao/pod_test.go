@@ -57,3 +57,36 @@ func TestGetDefaultContainer(t *testing.T) {
 		})
 	}
 }
+
+func TestIsCrashLooping(t *testing.T) {
+	crash := v1.ContainerStatus{State: v1.ContainerState{Waiting: &v1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}}}
+	pull := v1.ContainerStatus{State: v1.ContainerState{Waiting: &v1.ContainerStateWaiting{Reason: "ImagePullBackOff"}}}
+	running := v1.ContainerStatus{State: v1.ContainerState{Running: &v1.ContainerStateRunning{}}}
+
+	uu := map[string]struct {
+		status v1.PodStatus
+		e      bool
+	}{
+		"none": {},
+		"running": {
+			status: v1.PodStatus{ContainerStatuses: []v1.ContainerStatus{running}},
+		},
+		"pull": {
+			status: v1.PodStatus{ContainerStatuses: []v1.ContainerStatus{running, pull}},
+		},
+		"crash": {
+			status: v1.PodStatus{ContainerStatuses: []v1.ContainerStatus{running, crash}},
+			e:      true,
+		},
+		"init-crash": {
+			status: v1.PodStatus{InitContainerStatuses: []v1.ContainerStatus{crash}},
+			e:      true,
+		},
+	}
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, IsCrashLooping(&v1.Pod{Status: u.status}))
+		})
+	}
+}
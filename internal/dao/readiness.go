@@ -0,0 +1,249 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// ReadyState tracks a resource convergence state.
+type ReadyState int
+
+const (
+	// StatePending indicates the resource is still converging.
+	StatePending ReadyState = iota
+
+	// StateReady indicates the resource reached a ready/available state.
+	StateReady
+
+	// StateFailed indicates the resource will not converge on its own.
+	StateFailed
+)
+
+// String returns a state representation.
+func (s ReadyState) String() string {
+	switch s {
+	case StateReady:
+		return "Ready"
+	case StateFailed:
+		return "Failed"
+	default:
+		return "Pending"
+	}
+}
+
+var failedWaitingReasons = map[string]struct{}{
+	"CrashLoopBackOff":           {},
+	"ImagePullBackOff":           {},
+	"ErrImagePull":               {},
+	"InvalidImageName":           {},
+	"CreateContainerConfigError": {},
+	"CreateContainerError":       {},
+}
+
+// Readiness computes the convergence state of a resource along with a status message.
+func Readiness(u *unstructured.Unstructured) (ReadyState, string) {
+	if u.GetDeletionTimestamp() != nil {
+		return StateFailed, "resource is terminating"
+	}
+
+	var (
+		st  ReadyState
+		msg string
+		err error
+	)
+	switch u.GetKind() {
+	case "Deployment":
+		var dp appsv1.Deployment
+		if err = runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &dp); err == nil {
+			st, msg = dpReadiness(&dp)
+		}
+	case "StatefulSet":
+		var sts appsv1.StatefulSet
+		if err = runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &sts); err == nil {
+			st, msg = stsReadiness(&sts)
+		}
+	case "DaemonSet":
+		var ds appsv1.DaemonSet
+		if err = runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &ds); err == nil {
+			st, msg = dsReadiness(&ds)
+		}
+	case "ReplicaSet":
+		var rs appsv1.ReplicaSet
+		if err = runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &rs); err == nil {
+			st, msg = replicasReadiness(rs.Spec.Replicas, rs.Status.ReadyReplicas, "ready")
+		}
+	case "Pod":
+		var po v1.Pod
+		if err = runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &po); err == nil {
+			st, msg = podReadiness(&po)
+		}
+	case "Job":
+		var job batchv1.Job
+		if err = runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &job); err == nil {
+			st, msg = jobReadiness(&job)
+		}
+	default:
+		st, msg = conditionsReadiness(u)
+	}
+	if err != nil {
+		return StateFailed, err.Error()
+	}
+
+	return st, msg
+}
+
+func dpReadiness(dp *appsv1.Deployment) (ReadyState, string) {
+	if dp.Status.ObservedGeneration < dp.Generation {
+		return StatePending, "waiting for rollout to be observed"
+	}
+	for _, c := range dp.Status.Conditions {
+		if c.Type == appsv1.DeploymentProgressing && c.Status == v1.ConditionFalse {
+			return StateFailed, c.Message
+		}
+	}
+	desired := int32(1)
+	if dp.Spec.Replicas != nil {
+		desired = *dp.Spec.Replicas
+	}
+	if dp.Status.UpdatedReplicas < desired {
+		return StatePending, fmt.Sprintf("%d/%d replicas updated", dp.Status.UpdatedReplicas, desired)
+	}
+
+	return replicasReadiness(&desired, dp.Status.AvailableReplicas, "available")
+}
+
+func stsReadiness(sts *appsv1.StatefulSet) (ReadyState, string) {
+	if sts.Status.ObservedGeneration < sts.Generation {
+		return StatePending, "waiting for rollout to be observed"
+	}
+	desired := int32(1)
+	if sts.Spec.Replicas != nil {
+		desired = *sts.Spec.Replicas
+	}
+	if sts.Status.UpdatedReplicas < desired {
+		return StatePending, fmt.Sprintf("%d/%d replicas updated", sts.Status.UpdatedReplicas, desired)
+	}
+
+	return replicasReadiness(&desired, sts.Status.ReadyReplicas, "ready")
+}
+
+func dsReadiness(ds *appsv1.DaemonSet) (ReadyState, string) {
+	if ds.Status.ObservedGeneration < ds.Generation {
+		return StatePending, "waiting for rollout to be observed"
+	}
+	desired := ds.Status.DesiredNumberScheduled
+	if ds.Status.UpdatedNumberScheduled < desired {
+		return StatePending, fmt.Sprintf("%d/%d pods updated", ds.Status.UpdatedNumberScheduled, desired)
+	}
+
+	return replicasReadiness(&desired, ds.Status.NumberReady, "ready")
+}
+
+func replicasReadiness(desired *int32, current int32, verb string) (ReadyState, string) {
+	want := int32(1)
+	if desired != nil {
+		want = *desired
+	}
+	msg := fmt.Sprintf("%d/%d replicas %s", current, want, verb)
+	if current < want {
+		return StatePending, msg
+	}
+
+	return StateReady, msg
+}
+
+func podReadiness(po *v1.Pod) (ReadyState, string) {
+	switch po.Status.Phase {
+	case v1.PodSucceeded:
+		return StateReady, string(po.Status.Phase)
+	case v1.PodFailed:
+		return StateFailed, check(po.Status.Reason, string(po.Status.Phase))
+	}
+	for _, s := range po.Status.InitContainerStatuses {
+		if st, msg, ok := waitingFailure(&s); ok {
+			return st, msg
+		}
+	}
+	for _, s := range po.Status.ContainerStatuses {
+		if st, msg, ok := waitingFailure(&s); ok {
+			return st, msg
+		}
+	}
+	for _, c := range po.Status.Conditions {
+		if c.Type == v1.PodReady && c.Status == v1.ConditionTrue {
+			return StateReady, string(po.Status.Phase)
+		}
+	}
+
+	return StatePending, check(string(po.Status.Phase), string(v1.PodPending))
+}
+
+func waitingFailure(s *v1.ContainerStatus) (ReadyState, string, bool) {
+	if s.State.Waiting == nil {
+		return StatePending, "", false
+	}
+	if _, ok := failedWaitingReasons[s.State.Waiting.Reason]; !ok {
+		return StatePending, "", false
+	}
+
+	return StateFailed, fmt.Sprintf("container %s: %s", s.Name, s.State.Waiting.Reason), true
+}
+
+func jobReadiness(job *batchv1.Job) (ReadyState, string) {
+	for _, c := range job.Status.Conditions {
+		if c.Status != v1.ConditionTrue {
+			continue
+		}
+		switch c.Type {
+		case batchv1.JobComplete:
+			return StateReady, "completed"
+		case batchv1.JobFailed:
+			return StateFailed, check(c.Message, c.Reason)
+		}
+	}
+
+	return StatePending, fmt.Sprintf("%d active, %d succeeded", job.Status.Active, job.Status.Succeeded)
+}
+
+func conditionsReadiness(u *unstructured.Unstructured) (ReadyState, string) {
+	cc, ok, err := unstructured.NestedSlice(u.Object, "status", "conditions")
+	if err != nil || !ok || len(cc) == 0 {
+		return StateReady, "no status conditions to wait on"
+	}
+
+	for _, t := range []string{"Ready", "Available"} {
+		for _, c := range cc {
+			m, ok := c.(map[string]any)
+			if !ok || m["type"] != t {
+				continue
+			}
+			status, _ := m["status"].(string)
+			reason, _ := m["reason"].(string)
+			switch metav1.ConditionStatus(status) {
+			case metav1.ConditionTrue:
+				return StateReady, t
+			default:
+				return StatePending, check(reason, t+"="+status)
+			}
+		}
+	}
+
+	return StateReady, "no readiness condition to wait on"
+}
+
+func check(s, sub string) string {
+	if s == "" {
+		return sub
+	}
+
+	return s
+}
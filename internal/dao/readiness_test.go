@@ -0,0 +1,145 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestReadiness(t *testing.T) {
+	uu := map[string]struct {
+		o   map[string]any
+		st  dao.ReadyState
+		msg string
+	}{
+		"dp-ready": {
+			o: map[string]any{
+				"kind":     "Deployment",
+				"metadata": map[string]any{"name": "fred", "generation": int64(1)},
+				"spec":     map[string]any{"replicas": int64(2)},
+				"status": map[string]any{
+					"observedGeneration": int64(1),
+					"updatedReplicas":    int64(2),
+					"availableReplicas":  int64(2),
+				},
+			},
+			st:  dao.StateReady,
+			msg: "2/2 replicas available",
+		},
+		"dp-pending": {
+			o: map[string]any{
+				"kind":     "Deployment",
+				"metadata": map[string]any{"name": "fred", "generation": int64(2)},
+				"spec":     map[string]any{"replicas": int64(2)},
+				"status": map[string]any{
+					"observedGeneration": int64(1),
+				},
+			},
+			st:  dao.StatePending,
+			msg: "waiting for rollout to be observed",
+		},
+		"dp-failed": {
+			o: map[string]any{
+				"kind":     "Deployment",
+				"metadata": map[string]any{"name": "fred"},
+				"status": map[string]any{
+					"conditions": []any{
+						map[string]any{"type": "Progressing", "status": "False", "message": "deadline exceeded"},
+					},
+				},
+			},
+			st:  dao.StateFailed,
+			msg: "deadline exceeded",
+		},
+		"po-crashloop": {
+			o: map[string]any{
+				"kind":     "Pod",
+				"metadata": map[string]any{"name": "fred"},
+				"status": map[string]any{
+					"phase": "Running",
+					"containerStatuses": []any{
+						map[string]any{
+							"name":  "c1",
+							"state": map[string]any{"waiting": map[string]any{"reason": "CrashLoopBackOff"}},
+						},
+					},
+				},
+			},
+			st:  dao.StateFailed,
+			msg: "container c1: CrashLoopBackOff",
+		},
+		"po-ready": {
+			o: map[string]any{
+				"kind":     "Pod",
+				"metadata": map[string]any{"name": "fred"},
+				"status": map[string]any{
+					"phase": "Running",
+					"conditions": []any{
+						map[string]any{"type": "Ready", "status": "True"},
+					},
+				},
+			},
+			st:  dao.StateReady,
+			msg: "Running",
+		},
+		"job-complete": {
+			o: map[string]any{
+				"kind":     "Job",
+				"metadata": map[string]any{"name": "fred"},
+				"status": map[string]any{
+					"conditions": []any{
+						map[string]any{"type": "Complete", "status": "True"},
+					},
+				},
+			},
+			st:  dao.StateReady,
+			msg: "completed",
+		},
+		"cr-not-ready": {
+			o: map[string]any{
+				"kind":     "Fred",
+				"metadata": map[string]any{"name": "fred"},
+				"status": map[string]any{
+					"conditions": []any{
+						map[string]any{"type": "Ready", "status": "False", "reason": "Reconciling"},
+					},
+				},
+			},
+			st:  dao.StatePending,
+			msg: "Reconciling",
+		},
+		"cm": {
+			o: map[string]any{
+				"kind":     "ConfigMap",
+				"metadata": map[string]any{"name": "fred"},
+			},
+			st:  dao.StateReady,
+			msg: "no status conditions to wait on",
+		},
+		"terminating": {
+			o: map[string]any{
+				"kind": "ConfigMap",
+				"metadata": map[string]any{
+					"name":              "fred",
+					"deletionTimestamp": "2025-01-01T00:00:00Z",
+				},
+			},
+			st:  dao.StateFailed,
+			msg: "resource is terminating",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			st, msg := dao.Readiness(&unstructured.Unstructured{Object: u.o})
+			assert.Equal(t, u.st, st)
+			assert.Equal(t, u.msg, msg)
+		})
+	}
+}
@@ -209,6 +209,13 @@ func loadK9s(m ResourceMetas) {
 		SingularName: "xray",
 		Categories:   []string{k9sCat},
 	}
+	m[client.FlGVR] = &metav1.APIResource{
+		Name:         "fleets",
+		Kind:         "Fleet",
+		SingularName: "fleet",
+		ShortNames:   []string{"fl"},
+		Categories:   []string{k9sCat},
+	}
 	m[client.RefGVR] = &metav1.APIResource{
 		Name:         "references",
 		Kind:         "References",
@@ -239,6 +246,14 @@ func loadK9s(m ResourceMetas) {
 		Verbs:        []string{"delete"},
 		Categories:   []string{k9sCat},
 	}
+	m[client.WarnGVR] = &metav1.APIResource{
+		Name:         "warnings",
+		Kind:         "Warnings",
+		SingularName: "warning",
+		ShortNames:   []string{"warn"},
+		Verbs:        []string{"delete"},
+		Categories:   []string{k9sCat},
+	}
 	m[client.BeGVR] = &metav1.APIResource{
 		Name:         "benchmarks",
 		Kind:         "Benchmarks",
@@ -0,0 +1,124 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	// MaxSnapshots tracks the max number of snapshots kept per resource.
+	MaxSnapshots = 10
+
+	snapshotTimeFmt = "15:04:05"
+)
+
+// Snapshot tracks a resource state at a given time.
+type Snapshot struct {
+	At     time.Time
+	Object *unstructured.Unstructured
+}
+
+// Label returns a snapshot diff label.
+func (s Snapshot) Label() string {
+	return "snapshot@" + s.At.Format(snapshotTimeFmt)
+}
+
+// Snapshots tracks session resource snapshots history.
+type Snapshots struct {
+	snaps map[string][]Snapshot
+	mx    sync.RWMutex
+}
+
+// NewSnapshots returns a new snapshots store.
+func NewSnapshots() *Snapshots {
+	return &Snapshots{
+		snaps: make(map[string][]Snapshot),
+	}
+}
+
+// Take records a resource snapshot stripped off volatile fields and returns it.
+// Oldest snapshots are evicted past MaxSnapshots.
+func (s *Snapshots) Take(gvr *client.GVR, o *unstructured.Unstructured) Snapshot {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	snap := Snapshot{At: time.Now(), Object: StripVolatile(o)}
+	key := snapshotKey(gvr, client.FQN(o.GetNamespace(), o.GetName()))
+	ss := append(s.snaps[key], snap)
+	if len(ss) > MaxSnapshots {
+		ss = ss[len(ss)-MaxSnapshots:]
+	}
+	s.snaps[key] = ss
+
+	return snap
+}
+
+// List returns a resource snapshots, oldest first.
+func (s *Snapshots) List(gvr *client.GVR, fqn string) []Snapshot {
+	s.mx.RLock()
+	defer s.mx.RUnlock()
+
+	return append([]Snapshot(nil), s.snaps[snapshotKey(gvr, fqn)]...)
+}
+
+// Delete removes all snapshots for a given resource.
+func (s *Snapshots) Delete(gvr *client.GVR, fqn string) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	delete(s.snaps, snapshotKey(gvr, fqn))
+}
+
+// Clear removes all snapshots.
+func (s *Snapshots) Clear() {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	clear(s.snaps)
+}
+
+// DiffSnapshot returns a unified diff between a snapshot and a live resource.
+// Status is kept so controller driven mutations show up.
+func DiffSnapshot(snap Snapshot, live *unstructured.Unstructured) (string, error) {
+	a, err := toYAML(snap.Object)
+	if err != nil {
+		return "", err
+	}
+	b, err := toYAML(StripVolatile(live))
+	if err != nil {
+		return "", err
+	}
+
+	return unifiedDiff(a, b, snap.Label(), "live")
+}
+
+// StripVolatile returns a copy of a resource without server managed metadata.
+func StripVolatile(o *unstructured.Unstructured) *unstructured.Unstructured {
+	u := o.DeepCopy()
+	meta, ok := u.Object["metadata"].(map[string]any)
+	if !ok {
+		return u
+	}
+	for _, f := range volatileMetaFields {
+		delete(meta, f)
+	}
+	if aa, ok := meta["annotations"].(map[string]any); ok {
+		delete(aa, lastAppliedKey)
+		if len(aa) == 0 {
+			delete(meta, "annotations")
+		}
+	}
+
+	return u
+}
+
+func snapshotKey(gvr *client.GVR, fqn string) string {
+	return fmt.Sprintf("%s|%s", gvr, fqn)
+}
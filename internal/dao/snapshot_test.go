@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestSnapshotsTake(t *testing.T) {
+	ss := dao.NewSnapshots()
+	o := snapCM("1", "v1")
+	for range dao.MaxSnapshots + 2 {
+		ss.Take(client.CmGVR, o)
+	}
+
+	assert.Len(t, ss.List(client.CmGVR, "default/fred"), dao.MaxSnapshots)
+	assert.Empty(t, ss.List(client.SecGVR, "default/fred"))
+
+	snap := ss.List(client.CmGVR, "default/fred")[0]
+	_, ok, _ := unstructured.NestedString(snap.Object.Object, "metadata", "resourceVersion")
+	assert.False(t, ok)
+	_, ok, _ = unstructured.NestedString(o.Object, "metadata", "resourceVersion")
+	assert.True(t, ok)
+
+	ss.Delete(client.CmGVR, "default/fred")
+	assert.Empty(t, ss.List(client.CmGVR, "default/fred"))
+}
+
+func TestDiffSnapshot(t *testing.T) {
+	uu := map[string]struct {
+		live *unstructured.Unstructured
+		e    []string
+	}{
+		"same": {
+			live: snapCM("2", "v1"),
+		},
+		"changed": {
+			live: snapCM("3", "v2"),
+			e:    []string{"+++ live", "-  a: v1", "+  a: v2"},
+		},
+	}
+
+	ss := dao.NewSnapshots()
+	snap := ss.Take(client.CmGVR, snapCM("1", "v1"))
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			res, err := dao.DiffSnapshot(snap, u.live)
+			require.NoError(t, err)
+			if len(u.e) == 0 {
+				assert.Empty(t, res)
+				return
+			}
+			assert.True(t, strings.HasPrefix(res, "--- "+snap.Label()))
+			for _, e := range u.e {
+				assert.Contains(t, res, e)
+			}
+		})
+	}
+}
+
+func snapCM(rev, v string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata": map[string]any{
+			"namespace":       "default",
+			"name":            "fred",
+			"resourceVersion": rev,
+		},
+		"data": map[string]any{"a": v},
+	}}
+}
@@ -0,0 +1,174 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/watch"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+var _ watch.ServiceForwarder = (*ServiceForwarder)(nil)
+
+// ServiceForwarder tracks a port forward to a pod currently backing a service.
+type ServiceForwarder struct {
+	*PortForwarder
+
+	svc      string
+	canceled *atomic.Bool
+	retired  atomic.Bool
+}
+
+// NewServiceForwarder returns a new service backed port forwarder.
+// Forwarders sharing a cancel flag represent successive targets of the same service forward.
+func NewServiceForwarder(f Factory, svc string, canceled *atomic.Bool) *ServiceForwarder {
+	return &ServiceForwarder{
+		PortForwarder: NewPortForwarder(f),
+		svc:           svc,
+		canceled:      canceled,
+	}
+}
+
+// Service returns the forwarded service path.
+func (s *ServiceForwarder) Service() string {
+	return s.svc
+}
+
+// Pod returns the pod currently backing the forward.
+func (s *ServiceForwarder) Pod() string {
+	return s.path
+}
+
+// Stop terminates the service forward unless this forwarder was already retired.
+func (s *ServiceForwarder) Stop() {
+	if !s.retired.Load() {
+		s.canceled.Store(true)
+	}
+	s.PortForwarder.Stop()
+}
+
+// Retarget stops the current pod forward so a new backing pod can be picked.
+func (s *ServiceForwarder) Retarget() {
+	s.PortForwarder.Stop()
+}
+
+// Retire detaches the forwarder from its service forward and reports whether
+// the service forward should carry on with a new target.
+func (s *ServiceForwarder) Retire() bool {
+	s.retired.Store(true)
+
+	return !s.canceled.Load()
+}
+
+// Canceled checks if the service forward was terminated.
+func (s *ServiceForwarder) Canceled() bool {
+	return s.canceled.Load()
+}
+
+// ServingPod returns a running and ready pod matching a service selector.
+// The skip pod is only picked when no other candidate is available.
+func ServingPod(f Factory, svc *v1.Service, skip string) (*v1.Pod, error) {
+	if len(svc.Spec.Selector) == 0 {
+		return nil, fmt.Errorf("service %s does not provide any selectors", client.FQN(svc.Namespace, svc.Name))
+	}
+	oo, err := f.List(client.PodGVR, svc.Namespace, true, labels.Set(svc.Spec.Selector).AsSelector())
+	if err != nil {
+		return nil, err
+	}
+
+	pods := make([]*v1.Pod, 0, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			continue
+		}
+		var pod v1.Pod
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &pod); err != nil {
+			return nil, err
+		}
+		if IsPodServing(&pod) {
+			pods = append(pods, &pod)
+		}
+	}
+	if len(pods) == 0 {
+		return nil, fmt.Errorf("no ready pods backing service %s", client.FQN(svc.Namespace, svc.Name))
+	}
+	slices.SortFunc(pods, func(a, b *v1.Pod) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	for _, pod := range pods {
+		if client.FQN(pod.Namespace, pod.Name) != skip {
+			return pod, nil
+		}
+	}
+
+	return pods[0], nil
+}
+
+// IsPodServing checks if a pod is running, ready and not terminating.
+func IsPodServing(pod *v1.Pod) bool {
+	if pod.DeletionTimestamp != nil || pod.Status.Phase != v1.PodRunning {
+		return false
+	}
+	for _, c := range pod.Status.Conditions {
+		if c.Type == v1.PodReady {
+			return c.Status == v1.ConditionTrue
+		}
+	}
+
+	return false
+}
+
+// ServicePortFor returns a service port matching a port number or name.
+func ServicePortFor(svc *v1.Service, p string) (v1.ServicePort, error) {
+	for _, sp := range svc.Spec.Ports {
+		if sp.Name == p || strconv.Itoa(int(sp.Port)) == p {
+			return sp, nil
+		}
+	}
+
+	return v1.ServicePort{}, fmt.Errorf("no port %s on service %s", p, client.FQN(svc.Namespace, svc.Name))
+}
+
+// ServiceTargetPort resolves a service port to a backing pod container and port.
+func ServiceTargetPort(sp v1.ServicePort, pod *v1.Pod) (container string, port int32, err error) {
+	tp := sp.TargetPort
+	if tp.Type == intstr.String {
+		for _, co := range pod.Spec.Containers {
+			for _, cp := range co.Ports {
+				if cp.Name == tp.StrVal {
+					return co.Name, cp.ContainerPort, nil
+				}
+			}
+		}
+		return "", 0, fmt.Errorf("no container port named %q on pod %s", tp.StrVal, client.FQN(pod.Namespace, pod.Name))
+	}
+
+	port = tp.IntVal
+	if port == 0 {
+		port = sp.Port
+	}
+	for _, co := range pod.Spec.Containers {
+		for _, cp := range co.Ports {
+			if cp.ContainerPort == port {
+				return co.Name, port, nil
+			}
+		}
+	}
+	if len(pod.Spec.Containers) == 0 {
+		return "", 0, fmt.Errorf("no containers found on pod %s", client.FQN(pod.Namespace, pod.Name))
+	}
+
+	return pod.Spec.Containers[0].Name, port, nil
+}
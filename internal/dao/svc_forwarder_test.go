@@ -0,0 +1,143 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestServiceTargetPort(t *testing.T) {
+	pod := v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "fred"},
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{
+				{Name: "sidecar", Ports: []v1.ContainerPort{{Name: "metrics", ContainerPort: 9090}}},
+				{Name: "app", Ports: []v1.ContainerPort{{Name: "http", ContainerPort: 8080}}},
+			},
+		},
+	}
+
+	uu := map[string]struct {
+		sp  v1.ServicePort
+		co  string
+		p   int32
+		err bool
+	}{
+		"named": {
+			sp: v1.ServicePort{Port: 80, TargetPort: intstr.FromString("http")},
+			co: "app",
+			p:  8080,
+		},
+		"named-missing": {
+			sp:  v1.ServicePort{Port: 80, TargetPort: intstr.FromString("grpc")},
+			err: true,
+		},
+		"number": {
+			sp: v1.ServicePort{Port: 80, TargetPort: intstr.FromInt32(9090)},
+			co: "sidecar",
+			p:  9090,
+		},
+		"undeclared": {
+			sp: v1.ServicePort{Port: 80, TargetPort: intstr.FromInt32(3000)},
+			co: "sidecar",
+			p:  3000,
+		},
+		"defaulted": {
+			sp: v1.ServicePort{Port: 8080},
+			co: "app",
+			p:  8080,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			co, p, err := dao.ServiceTargetPort(u.sp, &pod)
+			if u.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, u.co, co)
+			assert.Equal(t, u.p, p)
+		})
+	}
+}
+
+func TestServicePortFor(t *testing.T) {
+	svc := v1.Service{
+		Spec: v1.ServiceSpec{
+			Ports: []v1.ServicePort{
+				{Name: "http", Port: 80},
+				{Name: "https", Port: 443},
+			},
+		},
+	}
+
+	uu := map[string]struct {
+		p   string
+		e   int32
+		err bool
+	}{
+		"number": {p: "443", e: 443},
+		"name":   {p: "http", e: 80},
+		"none":   {p: "8080", err: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			sp, err := dao.ServicePortFor(&svc, u.p)
+			if u.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, u.e, sp.Port)
+		})
+	}
+}
+
+func TestIsPodServing(t *testing.T) {
+	now := metav1.Now()
+	ready := []v1.PodCondition{{Type: v1.PodReady, Status: v1.ConditionTrue}}
+
+	uu := map[string]struct {
+		pod v1.Pod
+		e   bool
+	}{
+		"ready": {
+			pod: v1.Pod{Status: v1.PodStatus{Phase: v1.PodRunning, Conditions: ready}},
+			e:   true,
+		},
+		"not-ready": {
+			pod: v1.Pod{Status: v1.PodStatus{
+				Phase:      v1.PodRunning,
+				Conditions: []v1.PodCondition{{Type: v1.PodReady, Status: v1.ConditionFalse}},
+			}},
+		},
+		"pending": {
+			pod: v1.Pod{Status: v1.PodStatus{Phase: v1.PodPending}},
+		},
+		"terminating": {
+			pod: v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{DeletionTimestamp: &now},
+				Status:     v1.PodStatus{Phase: v1.PodRunning, Conditions: ready},
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, dao.IsPodServing(&u.pod))
+		})
+	}
+}
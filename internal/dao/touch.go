@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// Touch merges annotations and labels onto a resource.
+// Keys mapped to a blank value are removed.
+func Touch(ctx context.Context, f Factory, gvr *client.GVR, path string, annotations, labels map[string]string) error {
+	ns, n := client.Namespaced(path)
+	auth, err := f.Client().CanI(ns, gvr, n, client.PatchAccess)
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to patch %s", path)
+	}
+	patch, err := TouchPatch(annotations, labels)
+	if err != nil {
+		return err
+	}
+
+	return patchResource(ctx, f, gvr, path, types.MergePatchType, patch)
+}
+
+func patchResource(ctx context.Context, f Factory, gvr *client.GVR, path string, pt types.PatchType, patch []byte) error {
+	ns, n := client.Namespaced(path)
+	dial, err := f.Client().DynDial()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, f.Client().Config().CallTimeout())
+	defer cancel()
+
+	res := dial.Resource(gvr.GVR())
+	if client.IsClusterScoped(ns) {
+		_, err = res.Patch(ctx, n, pt, patch, metav1.PatchOptions{})
+		return err
+	}
+	_, err = res.Namespace(ns).Patch(ctx, n, pt, patch, metav1.PatchOptions{})
+
+	return err
+}
+
+// TouchPatch returns a metadata merge patch for the given annotations and labels.
+func TouchPatch(annotations, labels map[string]string) ([]byte, error) {
+	if len(annotations) == 0 && len(labels) == 0 {
+		return nil, errors.New("nothing to patch")
+	}
+	meta := make(map[string]any, 2)
+	if len(annotations) > 0 {
+		meta["annotations"] = toPatchValues(annotations)
+	}
+	if len(labels) > 0 {
+		meta["labels"] = toPatchValues(labels)
+	}
+
+	return json.Marshal(map[string]any{"metadata": meta})
+}
+
+func toPatchValues(kv map[string]string) map[string]any {
+	mm := make(map[string]any, len(kv))
+	for k, v := range kv {
+		if v == "" {
+			mm[k] = nil
+			continue
+		}
+		mm[k] = v
+	}
+
+	return mm
+}
@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTouchPatch(t *testing.T) {
+	uu := map[string]struct {
+		aa, ll map[string]string
+		err    bool
+		e      string
+	}{
+		"empty": {
+			err: true,
+		},
+		"annotation": {
+			aa: map[string]string{"a": "1"},
+			e:  `{"metadata":{"annotations":{"a":"1"}}}`,
+		},
+		"label": {
+			ll: map[string]string{"l": "1"},
+			e:  `{"metadata":{"labels":{"l":"1"}}}`,
+		},
+		"remove": {
+			aa: map[string]string{"a": ""},
+			ll: map[string]string{"l": "1"},
+			e:  `{"metadata":{"annotations":{"a":null},"labels":{"l":"1"}}}`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			bb, err := dao.TouchPatch(u.aa, u.ll)
+			if u.err {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.JSONEq(t, u.e, string(bb))
+		})
+	}
+}
@@ -175,6 +175,12 @@ type Sanitizer interface {
 	Sanitize(context.Context, string) (int, error)
 }
 
+// CrashLooper represents a resource with crashlooping instances.
+type CrashLooper interface {
+	// CrashLooping returns all pods in a crashloop state.
+	CrashLooping(context.Context, string) ([]*v1.Pod, error)
+}
+
 // Valuer represents a resource with values.
 type Valuer interface {
 	// GetValues returns values for a resource.
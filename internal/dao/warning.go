@@ -0,0 +1,40 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal/render"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var (
+	_ Accessor = (*Warning)(nil)
+	_ Nuker    = (*Warning)(nil)
+)
+
+// Warning represents api server warnings collected during the session.
+type Warning struct {
+	NonResource
+}
+
+// Delete removes a warning.
+func (w *Warning) Delete(_ context.Context, path string, _ *metav1.DeletionPropagation, _ Grace) error {
+	w.Client().Config().Warnings().Delete(path)
+
+	return nil
+}
+
+// List returns a collection of warnings.
+func (w *Warning) List(context.Context, string) ([]runtime.Object, error) {
+	ww := w.Client().Config().Warnings().List()
+	oo := make([]runtime.Object, 0, len(ww))
+	for _, warn := range ww {
+		oo = append(oo, render.WarningRes{Warning: warn})
+	}
+
+	return oo, nil
+}
@@ -0,0 +1,195 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package health
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/duration"
+)
+
+// Score tracks a resource health score.
+type Score int
+
+const (
+	// Neutral represents a resource without health rules.
+	Neutral Score = iota
+
+	// Healthy represents a resource in good standing.
+	Healthy
+
+	// Degraded represents a resource needing attention.
+	Degraded
+
+	// Failing represents a resource in trouble.
+	Failing
+)
+
+// String returns a score representation.
+func (s Score) String() string {
+	switch s {
+	case Healthy:
+		return "ok"
+	case Degraded:
+		return "warn"
+	case Failing:
+		return "crit"
+	default:
+		return "-"
+	}
+}
+
+// Verdict tracks a resource health score and its worst contributing factor.
+type Verdict struct {
+	Score  Score
+	Reason string
+}
+
+func (v *Verdict) worsen(s Score, reason string) {
+	if s > v.Score {
+		v.Score, v.Reason = s, reason
+	}
+}
+
+// Evaluate scores a resource against a health rule given its warning events count.
+func Evaluate(r config.HealthRule, o map[string]any, warnings int, now time.Time) Verdict {
+	v := Verdict{Score: Healthy, Reason: "healthy"}
+	switch phase, _, _ := unstructured.NestedString(o, "status", "phase"); phase {
+	case "Succeeded":
+		return Verdict{Score: Healthy, Reason: "completed"}
+	case "Failed":
+		v.worsen(Failing, "phase is Failed")
+	}
+	evalConditions(&v, r, o, now)
+	evalRestarts(&v, r, o)
+	if r.Replicas {
+		evalReplicas(&v, o)
+	}
+	switch {
+	case r.EventsCrit > 0 && warnings >= r.EventsCrit:
+		v.worsen(Failing, fmt.Sprintf("%d warning events", warnings))
+	case r.EventsWarn > 0 && warnings >= r.EventsWarn:
+		v.worsen(Degraded, fmt.Sprintf("%d warning events", warnings))
+	}
+
+	return v
+}
+
+func evalConditions(v *Verdict, r config.HealthRule, o map[string]any, now time.Time) {
+	if len(r.Conditions) == 0 {
+		return
+	}
+	cc, _, _ := unstructured.NestedSlice(o, "status", "conditions")
+	for _, t := range r.Conditions {
+		c, ok := findCondition(cc, t)
+		if !ok {
+			v.worsen(Degraded, fmt.Sprintf("%s condition missing", t))
+			continue
+		}
+		if status, _ := c["status"].(string); status == "True" {
+			continue
+		}
+		msg := fmt.Sprintf("%s=%v", t, c["status"])
+		if reason, _ := c["reason"].(string); reason != "" {
+			msg += " (" + reason + ")"
+		}
+		ts, _ := c["lastTransitionTime"].(string)
+		at, err := time.Parse(time.RFC3339, ts)
+		if err != nil {
+			v.worsen(Failing, msg)
+			continue
+		}
+		since := now.Sub(at)
+		msg += " for " + duration.HumanDuration(since)
+		if since >= r.StaleDuration() {
+			v.worsen(Failing, msg)
+		} else {
+			v.worsen(Degraded, msg)
+		}
+	}
+}
+
+func findCondition(cc []any, t string) (map[string]any, bool) {
+	for _, c := range cc {
+		m, ok := c.(map[string]any)
+		if !ok {
+			continue
+		}
+		if ct, _ := m["type"].(string); ct == t {
+			return m, true
+		}
+	}
+
+	return nil, false
+}
+
+func evalRestarts(v *Verdict, r config.HealthRule, o map[string]any) {
+	if r.RestartsWarn <= 0 && r.RestartsCrit <= 0 {
+		return
+	}
+	var restarts int64
+	for _, k := range []string{"initContainerStatuses", "containerStatuses"} {
+		ss, _, _ := unstructured.NestedSlice(o, "status", k)
+		for _, s := range ss {
+			if m, ok := s.(map[string]any); ok {
+				n, _, _ := unstructured.NestedInt64(m, "restartCount")
+				restarts += n
+			}
+		}
+	}
+	msg := fmt.Sprintf("%d restarts", restarts)
+	switch {
+	case r.RestartsCrit > 0 && restarts >= r.RestartsCrit:
+		v.worsen(Failing, msg)
+	case r.RestartsWarn > 0 && restarts >= r.RestartsWarn:
+		v.worsen(Degraded, msg)
+	}
+}
+
+func evalReplicas(v *Verdict, o map[string]any) {
+	desired, ok, _ := unstructured.NestedInt64(o, "spec", "replicas")
+	ready, _, _ := unstructured.NestedInt64(o, "status", "readyReplicas")
+	if !ok {
+		if desired, ok, _ = unstructured.NestedInt64(o, "status", "desiredNumberScheduled"); !ok {
+			return
+		}
+		ready, _, _ = unstructured.NestedInt64(o, "status", "numberReady")
+	}
+	if ready >= desired {
+		return
+	}
+	msg := fmt.Sprintf("%d/%d replicas ready", ready, desired)
+	if ready == 0 {
+		v.worsen(Failing, msg)
+		return
+	}
+	v.worsen(Degraded, msg)
+}
+
+// WarningEvents counts warning events per involved object uid.
+func WarningEvents(oo []runtime.Object) map[string]int {
+	counts := make(map[string]int, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			continue
+		}
+		if t, _, _ := unstructured.NestedString(u.Object, "type"); t != "Warning" {
+			continue
+		}
+		uid, ok, _ := unstructured.NestedString(u.Object, "regarding", "uid")
+		if !ok {
+			uid, _, _ = unstructured.NestedString(u.Object, "involvedObject", "uid")
+		}
+		if uid != "" {
+			counts[uid]++
+		}
+	}
+
+	return counts
+}
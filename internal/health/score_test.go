@@ -0,0 +1,139 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package health_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/health"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestEvaluate(t *testing.T) {
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	pod := config.DefaultHealthRules["v1/pods"]
+	dp := config.DefaultHealthRules["apps/v1/deployments"]
+
+	uu := map[string]struct {
+		r        config.HealthRule
+		o        map[string]any
+		warnings int
+		e        health.Verdict
+	}{
+		"healthy": {
+			r: pod,
+			o: podStatus("True", "2025-01-02T03:00:00Z", 0),
+			e: health.Verdict{Score: health.Healthy, Reason: "healthy"},
+		},
+		"completed": {
+			r: pod,
+			o: map[string]any{"status": map[string]any{"phase": "Succeeded"}},
+			e: health.Verdict{Score: health.Healthy, Reason: "completed"},
+		},
+		"not-ready-fresh": {
+			r: pod,
+			o: podStatus("False", "2025-01-02T03:03:05Z", 0),
+			e: health.Verdict{Score: health.Degraded, Reason: "Ready=False (ContainersNotReady) for 60s"},
+		},
+		"not-ready-stale": {
+			r: pod,
+			o: podStatus("False", "2025-01-02T02:04:05Z", 0),
+			e: health.Verdict{Score: health.Failing, Reason: "Ready=False (ContainersNotReady) for 60m"},
+		},
+		"restarts-warn": {
+			r: pod,
+			o: podStatus("True", "2025-01-02T03:00:00Z", 4),
+			e: health.Verdict{Score: health.Degraded, Reason: "4 restarts"},
+		},
+		"restarts-crit": {
+			r: pod,
+			o: podStatus("False", "2025-01-02T03:03:05Z", 12),
+			e: health.Verdict{Score: health.Failing, Reason: "12 restarts"},
+		},
+		"events": {
+			r:        pod,
+			o:        podStatus("True", "2025-01-02T03:00:00Z", 0),
+			warnings: 7,
+			e:        health.Verdict{Score: health.Degraded, Reason: "7 warning events"},
+		},
+		"missing-condition": {
+			r: dp,
+			o: map[string]any{"spec": map[string]any{"replicas": int64(1)}, "status": map[string]any{"readyReplicas": int64(1)}},
+			e: health.Verdict{Score: health.Degraded, Reason: "Available condition missing"},
+		},
+		"replicas-partial": {
+			r: config.HealthRule{Replicas: true},
+			o: map[string]any{"spec": map[string]any{"replicas": int64(3)}, "status": map[string]any{"readyReplicas": int64(2)}},
+			e: health.Verdict{Score: health.Degraded, Reason: "2/3 replicas ready"},
+		},
+		"replicas-none": {
+			r: config.HealthRule{Replicas: true},
+			o: map[string]any{"spec": map[string]any{"replicas": int64(3)}},
+			e: health.Verdict{Score: health.Failing, Reason: "0/3 replicas ready"},
+		},
+		"daemonset": {
+			r: config.HealthRule{Replicas: true},
+			o: map[string]any{"status": map[string]any{"desiredNumberScheduled": int64(2), "numberReady": int64(2)}},
+			e: health.Verdict{Score: health.Healthy, Reason: "healthy"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, health.Evaluate(u.r, u.o, u.warnings, now))
+		})
+	}
+}
+
+func TestWarningEvents(t *testing.T) {
+	oo := []runtime.Object{
+		event("Warning", "regarding", "u1"),
+		event("Warning", "involvedObject", "u1"),
+		event("Normal", "regarding", "u1"),
+		event("Warning", "regarding", "u2"),
+	}
+
+	assert.Equal(t, map[string]int{"u1": 2, "u2": 1}, health.WarningEvents(oo))
+}
+
+// Helpers...
+
+func podStatus(ready, at string, restarts int64) map[string]any {
+	return map[string]any{
+		"status": map[string]any{
+			"phase": "Running",
+			"conditions": []any{
+				map[string]any{
+					"type":               "Ready",
+					"status":             ready,
+					"reason":             reason(ready),
+					"lastTransitionTime": at,
+				},
+			},
+			"containerStatuses": []any{
+				map[string]any{"name": "c1", "restartCount": restarts},
+			},
+		},
+	}
+}
+
+func reason(status string) string {
+	if status == "True" {
+		return ""
+	}
+
+	return "ContainersNotReady"
+}
+
+func event(kind, ref, uid string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"type": kind,
+		ref:    map[string]any{"uid": uid},
+	}}
+}
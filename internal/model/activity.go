@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package model
+
+import (
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// Revision tracks a resource version along with its last known update time.
+type Revision struct {
+	Version string
+	Updated time.Time
+}
+
+type activityEntry struct {
+	version string
+	changed time.Time
+}
+
+// Activity tracks resources updates across refreshes.
+type Activity struct {
+	window time.Duration
+	seen   map[string]activityEntry
+	mx     sync.Mutex
+}
+
+// NewActivity returns a new activity tracker.
+func NewActivity(window time.Duration) *Activity {
+	return &Activity{
+		window: window,
+		seen:   make(map[string]activityEntry),
+	}
+}
+
+// Track records the current resources revisions and returns the ones updated within the window.
+// Resources seen for the first time rely on their last known update time.
+// Resources no longer present are forgotten.
+func (a *Activity) Track(rr map[string]Revision, now time.Time) sets.Set[string] {
+	a.mx.Lock()
+	defer a.mx.Unlock()
+
+	recent := sets.New[string]()
+	seen := make(map[string]activityEntry, len(rr))
+	for id, r := range rr {
+		e, ok := a.seen[id]
+		switch {
+		case !ok:
+			e = activityEntry{version: r.Version, changed: r.Updated}
+		case e.version != r.Version:
+			e = activityEntry{version: r.Version, changed: now}
+		}
+		seen[id] = e
+		if !e.changed.IsZero() && now.Sub(e.changed) < a.window {
+			recent.Insert(id)
+		}
+	}
+	a.seen = seen
+
+	return recent
+}
@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/model"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+func TestActivityTrack(t *testing.T) {
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	a := model.NewActivity(10 * time.Second)
+
+	recent := a.Track(map[string]model.Revision{
+		"ns/a": {Version: "1", Updated: now.Add(-time.Minute)},
+		"ns/b": {Version: "1", Updated: now.Add(-2 * time.Second)},
+		"ns/c": {Version: "1"},
+	}, now)
+	assert.Equal(t, sets.New("ns/b"), recent)
+
+	now = now.Add(5 * time.Second)
+	recent = a.Track(map[string]model.Revision{
+		"ns/a": {Version: "2", Updated: now.Add(-time.Minute)},
+		"ns/b": {Version: "1"},
+		"ns/c": {Version: "1"},
+	}, now)
+	assert.Equal(t, sets.New("ns/a", "ns/b"), recent)
+
+	now = now.Add(8 * time.Second)
+	recent = a.Track(map[string]model.Revision{
+		"ns/a": {Version: "2"},
+		"ns/c": {Version: "1"},
+	}, now)
+	assert.Equal(t, sets.New("ns/a"), recent)
+
+	now = now.Add(time.Minute)
+	recent = a.Track(map[string]model.Revision{
+		"ns/a": {Version: "2"},
+		"ns/b": {Version: "7"},
+	}, now)
+	assert.Empty(t, recent)
+}
@@ -0,0 +1,257 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package model
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/slogs"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	conditionRate = 2 * time.Second
+
+	// ReadyCondition matches resources that reached a ready/available state.
+	ReadyCondition = "ready"
+
+	reasonCondition = "reason"
+)
+
+// ConditionFunc checks if a resource matches a condition.
+type ConditionFunc func(*unstructured.Unstructured) (string, bool)
+
+// ConditionListener represents a condition watcher listener.
+type ConditionListener interface {
+	// ConditionFired notifies a watched condition was met.
+	ConditionFired(c *Condition, fqn, msg string)
+}
+
+// Condition tracks a user defined resource predicate.
+type Condition struct {
+	GVR       *client.GVR
+	Namespace string
+	Name      string
+	Expr      string
+
+	match ConditionFunc
+	fired map[string]struct{}
+}
+
+// NewCondition returns a new condition for a given resource or namespace if name is blank.
+func NewCondition(gvr *client.GVR, ns, n, expr string) (*Condition, error) {
+	f, err := ParseCondition(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Condition{
+		GVR:       gvr,
+		Namespace: ns,
+		Name:      n,
+		Expr:      expr,
+		match:     f,
+		fired:     make(map[string]struct{}),
+	}, nil
+}
+
+// String returns a condition description.
+func (c *Condition) String() string {
+	n := c.Name
+	if n == "" {
+		n = "*"
+	}
+
+	return fmt.Sprintf("%s %s %s", c.GVR.R(), client.FQN(c.Namespace, n), c.Expr)
+}
+
+// ParseCondition converts a condition expression into a predicate.
+// Supported expressions are `ready`, `reason=XXX` or `field.path=value`.
+func ParseCondition(expr string) (ConditionFunc, error) {
+	expr = strings.TrimSpace(expr)
+	if strings.EqualFold(expr, ReadyCondition) {
+		return isReady, nil
+	}
+	tokens := strings.SplitN(expr, "=", 2)
+	if len(tokens) != 2 || tokens[0] == "" || tokens[1] == "" {
+		return nil, fmt.Errorf("invalid condition %q (expecting ready, reason=xxx or field.path=value)", expr)
+	}
+	k, v := strings.TrimSpace(tokens[0]), strings.TrimSpace(tokens[1])
+	if k == reasonCondition {
+		return hasReason(v), nil
+	}
+
+	return hasField(strings.Split(strings.TrimPrefix(k, "."), "."), v), nil
+}
+
+func isReady(u *unstructured.Unstructured) (string, bool) {
+	st, msg := dao.Readiness(u)
+
+	return msg, st == dao.StateReady
+}
+
+func hasReason(r string) ConditionFunc {
+	return func(u *unstructured.Unstructured) (string, bool) {
+		for _, k := range []string{"phase", "reason"} {
+			if s, _, _ := unstructured.NestedString(u.Object, "status", k); s == r {
+				return r, true
+			}
+		}
+		for _, k := range []string{"initContainerStatuses", "containerStatuses"} {
+			cc, _, _ := unstructured.NestedSlice(u.Object, "status", k)
+			for _, c := range cc {
+				m, ok := c.(map[string]any)
+				if !ok {
+					continue
+				}
+				for _, st := range []string{"waiting", "terminated"} {
+					if s, _, _ := unstructured.NestedString(m, "state", st, "reason"); s == r {
+						return fmt.Sprintf("container %v: %s", m["name"], r), true
+					}
+				}
+			}
+		}
+
+		return "", false
+	}
+}
+
+func hasField(path []string, v string) ConditionFunc {
+	return func(u *unstructured.Unstructured) (string, bool) {
+		f, ok, err := unstructured.NestedFieldNoCopy(u.Object, path...)
+		if err != nil || !ok {
+			return "", false
+		}
+
+		return strings.Join(path, ".") + "=" + v, fmt.Sprintf("%v", f) == v
+	}
+}
+
+// Conditions tracks watched conditions for the session.
+type Conditions struct {
+	factory  dao.Factory
+	listener ConditionListener
+	cc       []*Condition
+	cancel   context.CancelFunc
+	mx       sync.RWMutex
+}
+
+// NewConditions returns a new conditions watcher.
+func NewConditions(f dao.Factory, l ConditionListener) *Conditions {
+	return &Conditions{
+		factory:  f,
+		listener: l,
+	}
+}
+
+// Add registers a new condition and starts watching if needed.
+func (c *Conditions) Add(cond *Condition) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	c.cc = append(c.cc, cond)
+	if c.cancel != nil {
+		return
+	}
+	var ctx context.Context
+	ctx, c.cancel = context.WithCancel(context.Background())
+	go c.watch(ctx)
+}
+
+// Clear removes all conditions.
+func (c *Conditions) Clear() {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	c.cc = nil
+	if c.cancel != nil {
+		c.cancel()
+		c.cancel = nil
+	}
+}
+
+// List returns all active conditions.
+func (c *Conditions) List() []*Condition {
+	c.mx.RLock()
+	defer c.mx.RUnlock()
+
+	return append([]*Condition(nil), c.cc...)
+}
+
+func (c *Conditions) watch(ctx context.Context) {
+	ticker := time.NewTicker(conditionRate)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			c.Check()
+		}
+	}
+}
+
+// Check evaluates all conditions and notifies the listener on matches.
+// Conditions targeting a single resource are dropped once fired.
+func (c *Conditions) Check() {
+	done := make(map[*Condition]struct{})
+	for _, cond := range c.List() {
+		if c.check(cond) {
+			done[cond] = struct{}{}
+		}
+	}
+	if len(done) == 0 {
+		return
+	}
+
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	cc := make([]*Condition, 0, len(c.cc))
+	for _, cond := range c.cc {
+		if _, ok := done[cond]; !ok {
+			cc = append(cc, cond)
+		}
+	}
+	c.cc = cc
+}
+
+func (c *Conditions) check(cond *Condition) bool {
+	oo, err := c.factory.List(cond.GVR, cond.Namespace, false, labels.Everything())
+	if err != nil {
+		slog.Debug("Condition list failed", slogs.GVR, cond.GVR, slogs.Error, err)
+		return false
+	}
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok || (cond.Name != "" && u.GetName() != cond.Name) {
+			continue
+		}
+		fqn := client.FQN(u.GetNamespace(), u.GetName())
+		if _, ok := cond.fired[fqn]; ok {
+			continue
+		}
+		msg, ok := cond.match(u)
+		if !ok {
+			continue
+		}
+		cond.fired[fqn] = struct{}{}
+		if c.listener != nil {
+			c.listener.ConditionFired(cond, fqn, msg)
+		}
+		if cond.Name != "" {
+			return true
+		}
+	}
+
+	return false
+}
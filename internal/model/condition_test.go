@@ -0,0 +1,160 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package model_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestParseCondition(t *testing.T) {
+	uu := map[string]struct {
+		expr string
+		o    map[string]any
+		msg  string
+		ok   bool
+		err  string
+	}{
+		"ready": {
+			expr: "ready",
+			o: map[string]any{
+				"kind":     "Pod",
+				"metadata": map[string]any{"name": "p1"},
+				"status": map[string]any{
+					"phase":      "Running",
+					"conditions": []any{map[string]any{"type": "Ready", "status": "True"}},
+				},
+			},
+			msg: "Running",
+			ok:  true,
+		},
+		"not-ready": {
+			expr: "Ready",
+			o: map[string]any{
+				"kind":     "Pod",
+				"metadata": map[string]any{"name": "p1"},
+				"status":   map[string]any{"phase": "Pending"},
+			},
+			msg: "Pending",
+		},
+		"reason": {
+			expr: "reason=CrashLoopBackOff",
+			o: map[string]any{
+				"kind":     "Pod",
+				"metadata": map[string]any{"name": "p1"},
+				"status": map[string]any{
+					"containerStatuses": []any{
+						map[string]any{
+							"name":  "c1",
+							"state": map[string]any{"waiting": map[string]any{"reason": "CrashLoopBackOff"}},
+						},
+					},
+				},
+			},
+			msg: "container c1: CrashLoopBackOff",
+			ok:  true,
+		},
+		"field": {
+			expr: ".status.phase=Succeeded",
+			o: map[string]any{
+				"kind":     "Pod",
+				"metadata": map[string]any{"name": "p1"},
+				"status":   map[string]any{"phase": "Succeeded"},
+			},
+			msg: "status.phase=Succeeded",
+			ok:  true,
+		},
+		"field-missing": {
+			expr: "status.fred=blee",
+			o: map[string]any{
+				"kind":     "Pod",
+				"metadata": map[string]any{"name": "p1"},
+			},
+		},
+		"invalid": {
+			expr: "fred",
+			err:  `invalid condition "fred" (expecting ready, reason=xxx or field.path=value)`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			f, err := model.ParseCondition(u.expr)
+			if u.err != "" {
+				require.EqualError(t, err, u.err)
+				return
+			}
+			require.NoError(t, err)
+			msg, ok := f(&unstructured.Unstructured{Object: u.o})
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.msg, msg)
+		})
+	}
+}
+
+func TestConditionsCheck(t *testing.T) {
+	f := condFactory{oo: []runtime.Object{
+		makeCondPod("p1", "Running"),
+		makeCondPod("p2", "Failed"),
+		makeCondPod("p3", "Failed"),
+	}}
+	l := condListener{}
+	cc := model.NewConditions(&f, &l)
+
+	c1, err := model.NewCondition(client.PodGVR, "default", "p1", "status.phase=Running")
+	require.NoError(t, err)
+	c2, err := model.NewCondition(client.PodGVR, "default", "", "status.phase=Failed")
+	require.NoError(t, err)
+	cc.Add(c1)
+	cc.Add(c2)
+	defer cc.Clear()
+
+	cc.Check()
+	assert.Equal(t, []string{"default/p1", "default/p2", "default/p3"}, l.fired)
+	assert.Len(t, cc.List(), 1)
+
+	cc.Check()
+	assert.Len(t, l.fired, 3)
+
+	cc.Clear()
+	assert.Empty(t, cc.List())
+}
+
+// Helpers...
+
+type condFactory struct {
+	testFactory
+	oo []runtime.Object
+}
+
+func (f *condFactory) List(*client.GVR, string, bool, labels.Selector) ([]runtime.Object, error) {
+	return f.oo, nil
+}
+
+type condListener struct {
+	fired []string
+}
+
+func (l *condListener) ConditionFired(_ *model.Condition, fqn, _ string) {
+	l.fired = append(l.fired, fqn)
+}
+
+func makeCondPod(n, phase string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"kind": "Pod",
+		"metadata": map[string]any{
+			"namespace": "default",
+			"name":      n,
+		},
+		"status": map[string]any{"phase": phase},
+	}}
+}
@@ -137,6 +137,17 @@ func (f *Flash) SetMessage(level FlashLevel, msg string) {
 	go f.refresh(ctx)
 }
 
+// Pin sets a flash message that remains visible until replaced or cleared.
+func (f *Flash) Pin(level FlashLevel, msg string) {
+	if f.cancel != nil {
+		f.cancel()
+		f.cancel = nil
+	}
+
+	f.setLevelMessage(LevelMessage{Level: level, Text: msg})
+	f.fireFlashChanged()
+}
+
 func (f *Flash) refresh(ctx context.Context) {
 	for {
 		select {
@@ -70,6 +70,24 @@ func TestFlashBurst(t *testing.T) {
 	assert.Equal(t, fmt.Sprintf("test-%d", count), m)
 }
 
+func TestFlashPin(t *testing.T) {
+	const delay = 1 * time.Millisecond
+
+	f := model.NewFlash(delay)
+	v := newFlash()
+	go v.listen(f.Channel())
+
+	f.Info("blee")
+	f.Pin(model.FlashWarn, "zorg")
+
+	time.Sleep(5 * delay)
+	s, l, m := v.getMetrics()
+	assert.Equal(t, 2, s)
+	assert.Equal(t, model.FlashWarn, l)
+	assert.Equal(t, "zorg", m)
+	assert.Equal(t, 0, v.clear)
+}
+
 type flash struct {
 	set, clear int
 	level      model.FlashLevel
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"strings"
 	"sync"
 	"time"
 
@@ -79,6 +80,22 @@ func (l *Log) SinceSeconds() int64 {
 	return l.logOptions.SinceSeconds
 }
 
+// IsSnapshot returns true if logs are fetched once without following.
+func (l *Log) IsSnapshot() bool {
+	l.mx.RLock()
+	defer l.mx.RUnlock()
+
+	return l.logOptions.Snapshot
+}
+
+// ToggleSnapshot toggles between a tail snapshot and live following.
+func (l *Log) ToggleSnapshot(ctx context.Context) {
+	l.mx.Lock()
+	l.logOptions.Snapshot, l.logOptions.Head = !l.logOptions.Snapshot, false
+	l.mx.Unlock()
+	l.Restart(ctx)
+}
+
 // IsHead returns log head option.
 func (l *Log) IsHead() bool {
 	l.mx.RLock()
@@ -93,22 +110,29 @@ func (l *Log) ToggleShowTimestamp(b bool) {
 	l.Refresh()
 }
 
+// SetLogLevels sets a classifier to color lines by level or nil to turn coloring off.
+func (l *Log) SetLogLevels(lv *dao.LogLevels) {
+	l.lines.SetLevels(lv)
+	l.Refresh()
+}
+
 func (l *Log) Head(ctx context.Context) {
 	l.mx.Lock()
-	l.logOptions.Head = true
+	l.logOptions.Head, l.logOptions.Snapshot = true, false
 	l.mx.Unlock()
 	l.Restart(ctx)
 }
 
 // SetSinceSeconds sets the logs retrieval time.
 func (l *Log) SetSinceSeconds(ctx context.Context, i int64) {
-	l.logOptions.SinceSeconds, l.logOptions.Head = i, false
+	l.logOptions.SinceSeconds, l.logOptions.Head, l.logOptions.Snapshot = i, false, false
 	l.Restart(ctx)
 }
 
 // Configure sets logger configuration.
 func (l *Log) Configure(opts config.Logger) {
 	l.logOptions.Lines = opts.TailCount
+	l.logOptions.SnapshotLines = opts.SnapshotTail
 	l.logOptions.SinceSeconds = opts.SinceSeconds
 }
 
@@ -205,6 +229,75 @@ func (l *Log) Filter(q string) {
 	l.fireLogBuffChanged(0)
 }
 
+// TimestampOf returns the timestamp of the log line displayed at a given row
+// out of rows displayed lines. The view and the buffer both drop their oldest
+// lines first so rows are matched up from the latest line. When the view
+// skipped lines, the closest prior line with the same text is used instead.
+// Lines that can't be resolved that way are reported as not found.
+func (l *Log) TimestampOf(line string, row, rows int) (time.Time, bool) {
+	showTime := l.logOptions.ShowTimestamp
+	ii, matches, err := l.displayed()
+	if err != nil {
+		return time.Time{}, false
+	}
+	at := len(matches) - (rows - row)
+	if at < 0 || at >= len(matches) {
+		return time.Time{}, false
+	}
+	line = strings.TrimSpace(line)
+	for i := at; i >= 0; i-- {
+		if it := ii[matches[i]]; strings.TrimSpace(it.Plain(showTime)) == line {
+			return it.Time()
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// LinesSince returns the log lines logged at or past a given time.
+// The current filter if any is honored.
+func (l *Log) LinesSince(t time.Time) ([]string, error) {
+	ii, matches, err := l.displayed()
+	if err != nil {
+		return nil, err
+	}
+	ll := make([]string, 0, len(matches))
+	for _, idx := range matches {
+		if ts, ok := ii[idx].Time(); !ok || ts.Before(t) {
+			continue
+		}
+		ll = append(ll, ii[idx].Plain(l.logOptions.ShowTimestamp))
+	}
+
+	return ll, nil
+}
+
+// displayed returns the log lines and the indexes of the lines matching the current filter.
+func (l *Log) displayed() ([]*dao.LogItem, []int, error) {
+	l.mx.RLock()
+	q := l.filter
+	l.mx.RUnlock()
+
+	matches, _, err := l.lines.Filter(0, q, l.logOptions.ShowTimestamp)
+	if err != nil {
+		return nil, nil, err
+	}
+	ii := l.lines.Items()
+	if matches == nil {
+		matches = make([]int, len(ii))
+		for i := range ii {
+			matches[i] = i
+		}
+	}
+	for i, idx := range matches {
+		if idx >= len(ii) {
+			return ii, matches[:i], nil
+		}
+	}
+
+	return ii, matches, nil
+}
+
 func (l *Log) cancel() {
 	l.mx.Lock()
 	defer l.mx.Unlock()
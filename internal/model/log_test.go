@@ -242,6 +242,82 @@ func TestToggleAllContainers(t *testing.T) {
 // ----------------------------------------------------------------------------
 // Helpers...
 
+func TestLogLinesSince(t *testing.T) {
+	uu := map[string]struct {
+		q, mark   string
+		row, rows int
+		ok        bool
+		e         []string
+	}{
+		"all": {
+			mark: "line-1",
+			rows: 4,
+			ok:   true,
+			e:    []string{"line-1", "ping", "line-2", "ping"},
+		},
+		"repeated": {
+			mark: "ping",
+			row:  1,
+			rows: 4,
+			ok:   true,
+			e:    []string{"ping", "line-2", "ping"},
+		},
+		"latest": {
+			mark: "ping",
+			row:  3,
+			rows: 4,
+			ok:   true,
+			e:    []string{"ping"},
+		},
+		"filtered": {
+			q:    "ping",
+			mark: "ping",
+			rows: 2,
+			ok:   true,
+			e:    []string{"ping", "ping"},
+		},
+		"view-skipped": {
+			mark: "line-1",
+			rows: 2,
+			ok:   true,
+			e:    []string{"line-1", "ping", "line-2", "ping"},
+		},
+		"unknown": {
+			mark: "blee",
+			rows: 4,
+		},
+		"out-of-range": {
+			mark: "line-1",
+			rows: 5,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			m := model.NewLog(client.NewGVR("fred"), makeLogOpts(10), 10*time.Millisecond)
+			m.Init(makeFactory())
+			now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+			data := dao.NewLogItems()
+			for i, l := range []string{"line-1", "ping", "line-2", "ping"} {
+				ts := now.Add(time.Duration(i) * time.Second).Format(time.RFC3339Nano)
+				data.Add(dao.NewLogItemFromString(fmt.Sprintf("%s %s", ts, l)))
+			}
+			m.Set(data)
+			m.Filter(u.q)
+
+			mark, ok := m.TimestampOf(u.mark, u.row, u.rows)
+			assert.Equal(t, u.ok, ok)
+			if !ok {
+				return
+			}
+			ll, err := m.LinesSince(mark)
+			assert.NoError(t, err)
+			assert.Equal(t, u.e, ll)
+		})
+	}
+}
+
 func makeLogOpts(count int) *dao.LogOptions {
 	return &dao.LogOptions{
 		Path:      "fred",
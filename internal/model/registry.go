@@ -55,6 +55,14 @@ var Registry = map[*client.GVR]ResourceMeta{
 		DAO:      new(dao.ScreenDump),
 		Renderer: new(render.ScreenDump),
 	},
+	client.WarnGVR: {
+		DAO:      new(dao.Warning),
+		Renderer: new(render.Warning),
+	},
+	client.FlGVR: {
+		DAO:      new(dao.Fleet),
+		Renderer: new(render.Fleet),
+	},
 	client.RbacGVR: {
 		DAO:      new(dao.Rbac),
 		Renderer: new(render.Rbac),
@@ -113,6 +113,14 @@ func durationToSeconds(duration string) int64 {
 		return math.MaxInt64
 	}
 
+	var sign int64 = 1
+	switch duration[0] {
+	case '-':
+		sign, duration = -1, duration[1:]
+	case '+':
+		duration = duration[1:]
+	}
+
 	num := make([]rune, 0, 5)
 	var n, m int64
 	for _, r := range duration {
@@ -134,7 +142,7 @@ func durationToSeconds(duration string) int64 {
 		n, num = n+runesToNum(num)*m, num[:0]
 	}
 
-	return n
+	return sign * n
 }
 
 func runesToNum(rr []rune) int64 {
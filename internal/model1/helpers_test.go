@@ -115,6 +115,8 @@ func TestDurationToSecond(t *testing.T) {
 		"year":                    {s: "3y", e: 94608000},
 		"year_day":                {s: "1y2d", e: 31708800},
 		"n/a":                     {s: NAValue, e: math.MaxInt64},
+		"after":                   {s: "+2m", e: 120},
+		"before":                  {s: "-1h2m", e: -3720},
 	}
 
 	for k := range uu {
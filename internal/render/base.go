@@ -18,6 +18,12 @@ type DecoratorFunc func(string) string
 // AgeDecorator represents a timestamped as human column.
 var AgeDecorator = toAgeHuman
 
+// VulDecorator colors a vulnerability score by its worst severity.
+var VulDecorator = toVulColor
+
+// HashDecorator colors a revision hash so resources sharing a revision share a color.
+var HashDecorator = toHashColor
+
 type Base struct {
 	vs         *config.ViewSetting
 	specs      ColumnSpecs
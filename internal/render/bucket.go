@@ -0,0 +1,108 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Bucket tracks a utilization severity bucket.
+type Bucket int
+
+const (
+	// BucketUnknown represents a utilization without a limit to compare against.
+	BucketUnknown Bucket = iota
+
+	// BucketLow represents a low utilization.
+	BucketLow
+
+	// BucketMed represents a medium utilization.
+	BucketMed
+
+	// BucketHigh represents a high utilization.
+	BucketHigh
+
+	// BucketCritical represents a utilization at or over the limit.
+	BucketCritical
+)
+
+// Utilization buckets thresholds in percent.
+var (
+	BucketMedThreshold      = 70.0
+	BucketHighThreshold     = 90.0
+	BucketCriticalThreshold = 100.0
+)
+
+// String returns a bucket representation.
+func (b Bucket) String() string {
+	switch b {
+	case BucketLow:
+		return "low"
+	case BucketMed:
+		return "med"
+	case BucketHigh:
+		return "high"
+	case BucketCritical:
+		return "critical"
+	default:
+		return "unknown"
+	}
+}
+
+// ToBucket returns a severity bucket for a given utilization percentage.
+func ToBucket(pct float64) Bucket {
+	switch {
+	case pct >= BucketCriticalThreshold:
+		return BucketCritical
+	case pct >= BucketHighThreshold:
+		return BucketHigh
+	case pct >= BucketMedThreshold:
+		return BucketMed
+	default:
+		return BucketLow
+	}
+}
+
+// Age buckets thresholds. Younger ages may be flapping, older ones may be stale.
+var (
+	AgeNewThreshold = 5 * time.Minute
+	AgeOldThreshold = 90 * 24 * time.Hour
+)
+
+// AgeBucket returns a severity bucket for a given creation time.
+// New resources are high, old ones medium and the rest low.
+func AgeBucket(t metav1.Time) Bucket {
+	if t.IsZero() {
+		return BucketUnknown
+	}
+	switch age := time.Since(t.Time); {
+	case age < AgeNewThreshold:
+		return BucketHigh
+	case age > AgeOldThreshold:
+		return BucketMed
+	default:
+		return BucketLow
+	}
+}
+
+// ExpirySoonThreshold tracks how close to a deadline an expiry is flagged.
+var ExpirySoonThreshold = 7 * 24 * time.Hour
+
+// ExpiryBucket returns a severity bucket for a given deadline.
+// Passed deadlines are critical and the ones nearing expiry high.
+func ExpiryBucket(t metav1.Time) Bucket {
+	if t.IsZero() {
+		return BucketUnknown
+	}
+	switch d := time.Until(t.Time); {
+	case d <= 0:
+		return BucketCritical
+	case d < ExpirySoonThreshold:
+		return BucketHigh
+	default:
+		return BucketLow
+	}
+}
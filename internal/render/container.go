@@ -19,7 +19,24 @@ import (
 	mv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
 )
 
-const falseStr = "false"
+const (
+	falseStr = "false"
+
+	// ImageDefault indicates a container relies on its image entrypoint/cmd.
+	ImageDefault = "<image default>"
+
+	// EntrypointImage indicates the image entrypoint runs with the image cmd.
+	EntrypointImage = "image"
+
+	// EntrypointArgsOverride indicates the image entrypoint runs with the container args.
+	EntrypointArgsOverride = "image (args override)"
+
+	// EntrypointOverride indicates the container command replaces the image entrypoint.
+	EntrypointOverride = "override"
+
+	// maxArgsLen bounds command and args cells. Full values are shown by the container view command details.
+	maxArgsLen = 64
+)
 
 // ContainerWithMetrics represents a container and it's metrics.
 type ContainerWithMetrics interface {
@@ -90,11 +107,15 @@ var defaultCOHeader = model1.Header{
 	model1.HeaderColumn{Name: "%CPU/R", Attrs: model1.Attrs{Align: tview.AlignRight, MX: true}},
 	model1.HeaderColumn{Name: "%CPU/L", Attrs: model1.Attrs{Align: tview.AlignRight, MX: true}},
 	// model1.HeaderColumn{Name: "MEM", Attrs: model1.Attrs{Align: tview.AlignRight, MX: true}},
-	// model1.HeaderColumn{Name: "MEM/RL", Attrs: model1.Attrs{Align: tview.AlignRight}},
+	model1.HeaderColumn{Name: "MEM/RL", Attrs: model1.Attrs{Align: tview.AlignRight, MX: true, Wide: true}},
 	model1.HeaderColumn{Name: "%MEM/R", Attrs: model1.Attrs{Align: tview.AlignRight, MX: true}},
 	model1.HeaderColumn{Name: "%MEM/L", Attrs: model1.Attrs{Align: tview.AlignRight, MX: true}},
 	model1.HeaderColumn{Name: "GPU/RL", Attrs: model1.Attrs{Align: tview.AlignRight}},
 	model1.HeaderColumn{Name: "PORTS"},
+	model1.HeaderColumn{Name: "ENTRYPOINT", Attrs: model1.Attrs{Wide: true}},
+	model1.HeaderColumn{Name: "COMMAND", Attrs: model1.Attrs{Wide: true}},
+	model1.HeaderColumn{Name: "ARGS", Attrs: model1.Attrs{Wide: true}},
+	model1.HeaderColumn{Name: "WORKDIR", Attrs: model1.Attrs{Wide: true}},
 	model1.HeaderColumn{Name: "VALID", Attrs: model1.Attrs{Wide: true}},
 	model1.HeaderColumn{Name: "AGE", Attrs: model1.Attrs{Time: true}},
 }
@@ -111,6 +132,7 @@ func (c Container) Render(o any, _ string, row *model1.Row) error {
 
 func (c Container) defaultRow(cr ContainerRes, r *model1.Row) error {
 	cur, res := gatherContainerMX(cr.Container, cr.MX)
+	memL, memB := memPctBucket(cur.mem, res.lmem)
 	ready, state, restarts := falseStr, MissingValue, "0"
 	if cr.Status != nil {
 		ready, state, restarts = boolToStr(cr.Status.Ready), ToContainerState(cr.Status.State), strconv.Itoa(int(cr.Status.RestartCount))
@@ -125,18 +147,22 @@ func (c Container) defaultRow(cr ContainerRes, r *model1.Row) error {
 		ready,
 		state,
 		restarts,
-		probe(cr.Container.LivenessProbe) + ":" + probe(cr.Container.ReadinessProbe) + ":" + probe(cr.Container.StartupProbe),
+		joinKeep([]string{probe(cr.Container.LivenessProbe), probe(cr.Container.ReadinessProbe), probe(cr.Container.StartupProbe)}, ":"),
 		// toMc(cur.cpu),
 		// toMc(res.cpu) + ":" + toMc(res.lcpu),
-		decimalPct(cur.cpu, res.cpu),
-		decimalPct(cur.cpu, res.lcpu),
+		cpuPct(cur.cpu, res.cpu),
+		cpuPctCollapsed(cur.cpu, res.lcpu),
 		// toMi(cur.mem),
-		// toMi(res.mem) + ":" + toMi(res.lmem),
+		memReqLim(cur.mem, res.mem, res.lmem),
 		memPct(cur.mem, res.mem),
-		memPct(cur.mem, res.lmem),
+		memL,
 		toMc(res.gpu) + ":" + toMc(res.lgpu),
 		ToContainerPorts(cr.Container.Ports),
-		AsStatus(c.diagnose(state, ready)),
+		ToEntrypoint(cr.Container),
+		toCommand(cr.Container.Command),
+		toArgs(cr.Container),
+		toWorkingDir(cr.Container.WorkingDir),
+		AsStatus(c.diagnose(state, ready, memB)),
 		ToAge(cr.Age),
 	}
 
@@ -144,7 +170,7 @@ func (c Container) defaultRow(cr ContainerRes, r *model1.Row) error {
 }
 
 // Happy returns true if resource is happy, false otherwise.
-func (Container) diagnose(state, ready string) error {
+func (Container) diagnose(state, ready string, mem Bucket) error {
 	if state == "Completed" {
 		return nil
 	}
@@ -152,6 +178,9 @@ func (Container) diagnose(state, ready string) error {
 	if ready == falseStr {
 		return errors.New("container is not ready")
 	}
+	if mem == BucketCritical {
+		return errors.New("container memory limit reached")
+	}
 	return nil
 }
 
@@ -244,11 +273,92 @@ func ToContainerState(s v1.ContainerState) string {
 	}
 }
 
+// FormatContainerState returns a container state most informative reason and its severity bucket.
+// Terminated states note their exit code ie OOMKilled (137).
+func FormatContainerState(s v1.ContainerState) (string, Bucket) {
+	switch {
+	case s.Waiting != nil:
+		r := check(s.Waiting.Reason, "Waiting")
+		switch r {
+		case PhaseCrashLoop, PhaseImagePullBackOff, "ErrImagePull", "CreateContainerConfigError", "InvalidImageName":
+			return r, BucketCritical
+		case PhaseContainerCreating, PhasePodInitializing:
+			return r, BucketMed
+		default:
+			return r, BucketHigh
+		}
+	case s.Terminated != nil:
+		r := check(s.Terminated.Reason, "Terminated") + " (" + strconv.Itoa(int(s.Terminated.ExitCode)) + ")"
+		switch {
+		case s.Terminated.Reason == PhaseOOMKilled || s.Terminated.Reason == PhaseError:
+			return r, BucketCritical
+		case s.Terminated.ExitCode != 0:
+			return r, BucketHigh
+		default:
+			return r, BucketLow
+		}
+	case s.Running != nil:
+		return PhaseRunning, BucketLow
+	default:
+		return MissingValue, BucketUnknown
+	}
+}
+
 const (
 	on  = "on"
 	off = "off"
 )
 
+const (
+	// defaultProbeFailures tracks the Kubernetes default probe failure threshold.
+	defaultProbeFailures = 3
+
+	// defaultProbePeriod tracks the Kubernetes default probe period in seconds.
+	defaultProbePeriod = 10
+
+	// maxProbeCmd tracks the max width of an exec probe command.
+	maxProbeCmd = 30
+)
+
+// FormatProbe summarizes a probe handler, failure threshold and period ie HTTP :8080/healthz ×3 @10s.
+func FormatProbe(p *v1.Probe) string {
+	if p == nil {
+		return NAValue
+	}
+	failures, period := p.FailureThreshold, p.PeriodSeconds
+	if failures == 0 {
+		failures = defaultProbeFailures
+	}
+	if period == 0 {
+		period = defaultProbePeriod
+	}
+
+	return fmt.Sprintf("%s ×%d @%ds", probeHandler(&p.ProbeHandler), failures, period)
+}
+
+func probeHandler(h *v1.ProbeHandler) string {
+	switch {
+	case h.HTTPGet != nil:
+		scheme := string(v1.URISchemeHTTP)
+		if h.HTTPGet.Scheme != "" {
+			scheme = string(h.HTTPGet.Scheme)
+		}
+		return scheme + " " + h.HTTPGet.Host + ":" + h.HTTPGet.Port.String() + h.HTTPGet.Path
+	case h.TCPSocket != nil:
+		return "TCP " + h.TCPSocket.Host + ":" + h.TCPSocket.Port.String()
+	case h.GRPC != nil:
+		s := "gRPC :" + strconv.Itoa(int(h.GRPC.Port))
+		if h.GRPC.Service != nil && *h.GRPC.Service != "" {
+			s += "/" + *h.GRPC.Service
+		}
+		return s
+	case h.Exec != nil:
+		return "exec " + Truncate(strings.Join(h.Exec.Command, " "), maxProbeCmd)
+	default:
+		return UnknownValue
+	}
+}
+
 func probe(p *v1.Probe) string {
 	if p == nil {
 		return off
@@ -274,3 +384,57 @@ func (ContainerRes) GetObjectKind() schema.ObjectKind {
 func (c ContainerRes) DeepCopyObject() runtime.Object {
 	return c
 }
+
+// ToCommandLine converts a command or args list into a shell like command line.
+func ToCommandLine(cc []string) string {
+	ss := make([]string, 0, len(cc))
+	for _, c := range cc {
+		if c == "" || strings.ContainsAny(c, " \t\n\"'") {
+			c = strconv.Quote(c)
+		}
+		ss = append(ss, c)
+	}
+
+	return strings.Join(ss, " ")
+}
+
+// ToEntrypoint reports what a container runs, either the image entrypoint as is,
+// the image entrypoint with overridden args or an overridden command.
+func ToEntrypoint(co *v1.Container) string {
+	switch {
+	case len(co.Command) > 0:
+		return EntrypointOverride
+	case len(co.Args) > 0:
+		return EntrypointArgsOverride
+	default:
+		return EntrypointImage
+	}
+}
+
+func toCommand(cc []string) string {
+	if len(cc) == 0 {
+		return ImageDefault
+	}
+
+	return Truncate(ToCommandLine(cc), maxArgsLen)
+}
+
+func toWorkingDir(d string) string {
+	if d == "" {
+		return ImageDefault
+	}
+
+	return d
+}
+
+// toArgs renders container args. Overriding the command drops the image cmd.
+func toArgs(co *v1.Container) string {
+	if len(co.Args) == 0 {
+		if len(co.Command) > 0 {
+			return NAValue
+		}
+		return ImageDefault
+	}
+
+	return Truncate(ToCommandLine(co.Args), maxArgsLen)
+}
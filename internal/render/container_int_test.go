@@ -157,3 +157,47 @@ func Test_gatherContainerMX(t *testing.T) {
 		})
 	}
 }
+
+func TestContainerDiagnose(t *testing.T) {
+	uu := map[string]struct {
+		state, ready string
+		mem          Bucket
+		e            string
+	}{
+		"happy": {
+			state: "Running",
+			ready: "true",
+			mem:   BucketMed,
+		},
+		"not-ready": {
+			state: "Running",
+			ready: "false",
+			mem:   BucketCritical,
+			e:     "container is not ready",
+		},
+		"mem-limit": {
+			state: "Running",
+			ready: "true",
+			mem:   BucketCritical,
+			e:     "container memory limit reached",
+		},
+		"completed": {
+			state: "Completed",
+			ready: "false",
+			mem:   BucketCritical,
+		},
+	}
+
+	var c Container
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			err := c.diagnose(u.state, u.ready, u.mem)
+			if u.e == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, u.e)
+		})
+	}
+}
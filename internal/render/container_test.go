@@ -5,6 +5,7 @@ package render_test
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -15,6 +16,7 @@ import (
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	mv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
 )
 
@@ -39,22 +41,78 @@ func TestContainer(t *testing.T) {
 		"Running",
 		"0",
 		"off:off:off",
-		"10",
-		"20:20",
-		"50",
-		"50",
-		"20",
-		"100:100",
-		"20",
-		"20",
+		".01/.02(50%)",
+		".01/.02(50%)",
+		"20M/100M req(20%)/100M lim(20%)",
+		"20M/100M(20%)",
+		"20M/100M(20%)",
 		"0:0",
 		"",
+		"image",
+		"<image default>",
+		"<image default>",
+		"<image default>",
 		"container is not ready",
 	},
 		r.Fields[:len(r.Fields)-1],
 	)
 }
 
+func TestContainerCommand(t *testing.T) {
+	uu := map[string]struct {
+		cmd, args       []string
+		wd              string
+		ep, ec, ea, ewd string
+	}{
+		"image": {
+			ep:  render.EntrypointImage,
+			ec:  render.ImageDefault,
+			ea:  render.ImageDefault,
+			ewd: render.ImageDefault,
+		},
+		"args-only": {
+			args: []string{"--port", "8080"},
+			wd:   "/app",
+			ep:   render.EntrypointArgsOverride,
+			ec:   render.ImageDefault,
+			ea:   "--port 8080",
+			ewd:  "/app",
+		},
+		"override": {
+			cmd: []string{"sh", "-c", "echo hello world"},
+			ep:  render.EntrypointOverride,
+			ec:  `sh -c "echo hello world"`,
+			ea:  "",
+			ewd: render.ImageDefault,
+		},
+		"long": {
+			cmd:  []string{"app"},
+			args: []string{strings.Repeat("a", 40), strings.Repeat("b", 40)},
+			ep:   render.EntrypointOverride,
+			ec:   "app",
+			ea:   strings.Repeat("a", 40) + " " + strings.Repeat("b", 22) + "…",
+			ewd:  render.ImageDefault,
+		},
+	}
+
+	var c render.Container
+	h := c.Header("")
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			co := makeContainer()
+			co.Command, co.Args, co.WorkingDir = u.cmd, u.args, u.wd
+			var r model1.Row
+			require.NoError(t, c.Render(render.ContainerRes{Container: co, Age: makeAge()}, "", &r))
+			for col, e := range map[string]string{"ENTRYPOINT": u.ep, "COMMAND": u.ec, "ARGS": u.ea, "WORKDIR": u.ewd} {
+				idx, ok := h.IndexOf(col, true)
+				require.True(t, ok)
+				assert.Equal(t, e, r.Fields[idx], col)
+			}
+		})
+	}
+}
+
 func BenchmarkContainerRender(b *testing.B) {
 	var (
 		c    render.Container
@@ -133,3 +191,143 @@ func testTime() time.Time {
 	}
 	return t
 }
+
+func TestFormatProbe(t *testing.T) {
+	svc := "health"
+
+	uu := map[string]struct {
+		p *v1.Probe
+		e string
+	}{
+		"nil": {
+			e: render.NAValue,
+		},
+		"http": {
+			p: &v1.Probe{
+				ProbeHandler: v1.ProbeHandler{
+					HTTPGet: &v1.HTTPGetAction{Path: "/healthz", Port: intstr.FromInt32(8080)},
+				},
+				FailureThreshold: 3,
+				PeriodSeconds:    10,
+			},
+			e: "HTTP :8080/healthz ×3 @10s",
+		},
+		"https-named-port": {
+			p: &v1.Probe{
+				ProbeHandler: v1.ProbeHandler{
+					HTTPGet: &v1.HTTPGetAction{Scheme: v1.URISchemeHTTPS, Path: "/ready", Port: intstr.FromString("web")},
+				},
+				FailureThreshold: 5,
+				PeriodSeconds:    2,
+			},
+			e: "HTTPS :web/ready ×5 @2s",
+		},
+		"tcp-defaults": {
+			p: &v1.Probe{
+				ProbeHandler: v1.ProbeHandler{
+					TCPSocket: &v1.TCPSocketAction{Port: intstr.FromInt32(5432)},
+				},
+			},
+			e: "TCP :5432 ×3 @10s",
+		},
+		"grpc": {
+			p: &v1.Probe{
+				ProbeHandler: v1.ProbeHandler{
+					GRPC: &v1.GRPCAction{Port: 9090, Service: &svc},
+				},
+				FailureThreshold: 1,
+				PeriodSeconds:    30,
+			},
+			e: "gRPC :9090/health ×1 @30s",
+		},
+		"exec": {
+			p: &v1.Probe{
+				ProbeHandler: v1.ProbeHandler{
+					Exec: &v1.ExecAction{Command: []string{"cat", "/tmp/healthy"}},
+				},
+			},
+			e: "exec cat /tmp/healthy ×3 @10s",
+		},
+		"exec-long": {
+			p: &v1.Probe{
+				ProbeHandler: v1.ProbeHandler{
+					Exec: &v1.ExecAction{Command: []string{"sh", "-c", "pg_isready -U postgres -h 127.0.0.1"}},
+				},
+			},
+			e: "exec sh -c pg_isready -U postgres … ×3 @10s",
+		},
+		"no-handler": {
+			p: &v1.Probe{},
+			e: "<unknown> ×3 @10s",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, render.FormatProbe(u.p))
+		})
+	}
+}
+
+func TestFormatContainerState(t *testing.T) {
+	uu := map[string]struct {
+		s v1.ContainerState
+		e string
+		b render.Bucket
+	}{
+		"empty": {
+			e: render.MissingValue,
+			b: render.BucketUnknown,
+		},
+		"running": {
+			s: v1.ContainerState{Running: &v1.ContainerStateRunning{}},
+			e: "Running",
+			b: render.BucketLow,
+		},
+		"crashloop": {
+			s: v1.ContainerState{Waiting: &v1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}},
+			e: "CrashLoopBackOff",
+			b: render.BucketCritical,
+		},
+		"creating": {
+			s: v1.ContainerState{Waiting: &v1.ContainerStateWaiting{Reason: "ContainerCreating"}},
+			e: "ContainerCreating",
+			b: render.BucketMed,
+		},
+		"waiting": {
+			s: v1.ContainerState{Waiting: &v1.ContainerStateWaiting{}},
+			e: "Waiting",
+			b: render.BucketHigh,
+		},
+		"oom": {
+			s: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{Reason: "OOMKilled", ExitCode: 137}},
+			e: "OOMKilled (137)",
+			b: render.BucketCritical,
+		},
+		"error": {
+			s: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{Reason: "Error", ExitCode: 1}},
+			e: "Error (1)",
+			b: render.BucketCritical,
+		},
+		"completed": {
+			s: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{Reason: "Completed"}},
+			e: "Completed (0)",
+			b: render.BucketLow,
+		},
+		"exit-code": {
+			s: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{ExitCode: 2}},
+			e: "Terminated (2)",
+			b: render.BucketHigh,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			s, b := render.FormatContainerState(u.s)
+			assert.Equal(t, u.e, s)
+			assert.Equal(t, u.b, b)
+		})
+	}
+}
@@ -19,7 +19,7 @@ import (
 var defaultCJHeader = model1.Header{
 	model1.HeaderColumn{Name: "NAMESPACE"},
 	model1.HeaderColumn{Name: "NAME"},
-	model1.HeaderColumn{Name: "VS", Attrs: model1.Attrs{VS: true}},
+	model1.HeaderColumn{Name: "VS", Attrs: model1.Attrs{VS: true, Decorator: VulDecorator}},
 	model1.HeaderColumn{Name: "SCHEDULE"},
 	model1.HeaderColumn{Name: "SUSPEND"},
 	model1.HeaderColumn{Name: "ACTIVE"},
@@ -81,7 +81,7 @@ func (CronJob) defaultRow(raw *unstructured.Unstructured, r *model1.Row) error {
 	r.Fields = model1.Fields{
 		cj.Namespace,
 		cj.Name,
-		computeVulScore(cj.Namespace, cj.Labels, &cj.Spec.JobTemplate.Spec.Template.Spec),
+		computeVulScore(cj.Namespace, cj.Labels, cj.Annotations, &cj.Spec.JobTemplate.Spec.Template.Spec),
 		cj.Spec.Schedule,
 		boolPtrToStr(cj.Spec.Suspend),
 		strconv.Itoa(len(cj.Status.Active)),
@@ -151,6 +151,13 @@ func hydrate(o runtime.Object, cc ColumnSpecs, parsers []*jsonpath.JSONPath, rh
 		parser := parsers[idx]
 		if parser == nil {
 			ix, ok := rh.IndexOf(cc[idx].Header.Name, true)
+			if !ok && cc[idx].Header.Name == SizeCol {
+				cols[idx] = RenderedCol{
+					Header: cc[idx].Header,
+					Value:  ToSize(o),
+				}
+				continue
+			}
 			if !ok {
 				cols[idx] = RenderedCol{
 					Header: cc[idx].Header,
@@ -205,10 +212,10 @@ func hydrate(o runtime.Object, cc ColumnSpecs, parsers []*jsonpath.JSONPath, rh
 				case cc[idx].Header.MXC:
 					switch k := v.(type) {
 					case resource.Quantity:
-						strVal = toMc(k.MilliValue())
+						strVal = mxcToStr(k.MilliValue())
 					case string:
 						if q, err := resource.ParseQuantity(k); err == nil {
-							strVal = toMc(q.MilliValue())
+							strVal = mxcToStr(q.MilliValue())
 						}
 					}
 				case cc[idx].Header.MXM:
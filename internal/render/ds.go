@@ -18,7 +18,7 @@ import (
 var defaultDSHeader = model1.Header{
 	model1.HeaderColumn{Name: "NAMESPACE"},
 	model1.HeaderColumn{Name: "NAME"},
-	model1.HeaderColumn{Name: "VS", Attrs: model1.Attrs{VS: true}},
+	model1.HeaderColumn{Name: "VS", Attrs: model1.Attrs{VS: true, Decorator: VulDecorator}},
 	model1.HeaderColumn{Name: "DESIRED", Attrs: model1.Attrs{Align: tview.AlignRight}},
 	model1.HeaderColumn{Name: "CURRENT", Attrs: model1.Attrs{Align: tview.AlignRight}},
 	model1.HeaderColumn{Name: "READY", Attrs: model1.Attrs{Align: tview.AlignRight}},
@@ -72,7 +72,7 @@ func (d DaemonSet) defaultRow(raw *unstructured.Unstructured, r *model1.Row) err
 	r.Fields = model1.Fields{
 		ds.Namespace,
 		ds.Name,
-		computeVulScore(ds.Namespace, ds.Labels, &ds.Spec.Template.Spec),
+		computeVulScore(ds.Namespace, ds.Labels, ds.Annotations, &ds.Spec.Template.Spec),
 		strconv.Itoa(int(ds.Status.DesiredNumberScheduled)),
 		strconv.Itoa(int(ds.Status.CurrentNumberScheduled)),
 		strconv.Itoa(int(ds.Status.NumberReady)),
@@ -0,0 +1,130 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tcell/v2"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// FleetError tracks contexts that could not be listed.
+	FleetError = "Error"
+
+	fleetPending = "Pending"
+	fleetFailed  = "Failed"
+)
+
+// Fleet renders a resource aggregated across contexts to screen.
+type Fleet struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (Fleet) ColorerFunc() model1.ColorerFunc {
+	return func(ns string, h model1.Header, re *model1.RowEvent) tcell.Color {
+		c := model1.DefaultColorer(ns, h, re)
+		idx, ok := h.IndexOf("STATUS", true)
+		if !ok || idx >= len(re.Row.Fields) {
+			return c
+		}
+		switch strings.TrimSpace(re.Row.Fields[idx]) {
+		case fleetPending:
+			c = model1.PendingColor
+		case fleetFailed, FleetError:
+			c = model1.ErrColor
+		}
+
+		return c
+	}
+}
+
+// Header returns a header row.
+func (Fleet) Header(string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "CONTEXT"},
+		model1.HeaderColumn{Name: "NAMESPACE"},
+		model1.HeaderColumn{Name: "NAME"},
+		model1.HeaderColumn{Name: "STATUS"},
+		model1.HeaderColumn{Name: "MESSAGE"},
+		model1.HeaderColumn{Name: "LABELS", Attrs: model1.Attrs{Wide: true}},
+		model1.HeaderColumn{Name: "VALID", Attrs: model1.Attrs{Wide: true}},
+		model1.HeaderColumn{Name: "AGE", Attrs: model1.Attrs{Time: true}},
+	}
+}
+
+// Render renders a fleet resource to screen.
+func (Fleet) Render(o any, _ string, r *model1.Row) error {
+	f, ok := o.(FleetRes)
+	if !ok {
+		return fmt.Errorf("expecting FleetRes, but got %T", o)
+	}
+
+	r.ID = f.ID()
+	if f.Err != nil {
+		r.Fields = model1.Fields{
+			f.Context,
+			"",
+			"",
+			FleetError,
+			f.Err.Error(),
+			"",
+			AsStatus(f.Err),
+			"",
+		}
+		return nil
+	}
+
+	var valid string
+	if f.Status == fleetFailed {
+		valid = f.Message
+	}
+	r.Fields = model1.Fields{
+		f.Context,
+		f.Object.GetNamespace(),
+		f.Object.GetName(),
+		f.Status,
+		f.Message,
+		mapToStr(f.Object.GetLabels()),
+		valid,
+		ToAge(f.Object.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+// FleetRes represents a resource instance in a given context.
+type FleetRes struct {
+	Context string
+	Object  *unstructured.Unstructured
+	Status  string
+	Message string
+	Err     error
+}
+
+// ID returns the resource identifier across contexts.
+func (f FleetRes) ID() string {
+	if f.Object == nil {
+		return f.Context + "@"
+	}
+
+	return f.Context + "@" + client.FQN(f.Object.GetNamespace(), f.Object.GetName())
+}
+
+// GetObjectKind returns a schema object.
+func (FleetRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a fleet resource copy.
+func (f FleetRes) DeepCopyObject() runtime.Object {
+	return f
+}
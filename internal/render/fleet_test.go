@@ -0,0 +1,63 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestFleetRender(t *testing.T) {
+	o := unstructured.Unstructured{Object: map[string]any{
+		"metadata": map[string]any{
+			"namespace": "ns1",
+			"name":      "n1",
+			"labels":    map[string]any{"a": "b"},
+		},
+	}}
+
+	uu := map[string]struct {
+		res render.FleetRes
+		e   model1.Row
+	}{
+		"ready": {
+			res: render.FleetRes{Context: "dev", Object: &o, Status: "Ready", Message: "1/1 ready"},
+			e: model1.Row{
+				ID:     "dev@ns1/n1",
+				Fields: model1.Fields{"dev", "ns1", "n1", "Ready", "1/1 ready", "a=b", ""},
+			},
+		},
+		"failed": {
+			res: render.FleetRes{Context: "dev", Object: &o, Status: "Failed", Message: "crash"},
+			e: model1.Row{
+				ID:     "dev@ns1/n1",
+				Fields: model1.Fields{"dev", "ns1", "n1", "Failed", "crash", "a=b", "crash"},
+			},
+		},
+		"error": {
+			res: render.FleetRes{Context: "prod", Err: errors.New("boom")},
+			e: model1.Row{
+				ID:     "prod@",
+				Fields: model1.Fields{"prod", "", "", render.FleetError, "boom", "", "boom", ""},
+			},
+		},
+	}
+
+	var f render.Fleet
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r model1.Row
+			require.NoError(t, f.Render(u.res, "", &r))
+			assert.Equal(t, u.e.ID, r.ID)
+			assert.Equal(t, u.e.Fields, r.Fields[:len(u.e.Fields)])
+		})
+	}
+}
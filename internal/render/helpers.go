@@ -5,64 +5,286 @@ package render
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"hash/fnv"
 	"log/slog"
 	"math"
 	"sort"
 	"strconv"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/slogs"
 	"github.com/derailed/k9s/internal/vul"
+	"github.com/derailed/tcell/v2"
 	"github.com/derailed/tview"
 	"github.com/mattn/go-runewidth"
+	"github.com/rivo/uniseg"
 	"golang.org/x/text/language"
 	"golang.org/x/text/message"
+	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/duration"
 )
 
-// ExtractImages returns a collection of container images.
-// !!BOZO!! If this has any legs?? enable scans on other container types.
+// ExtractImages returns a collection of unique container images.
+// Images are listed in containers, init containers then ephemeral containers order.
 func ExtractImages(spec *v1.PodSpec) []string {
-	ii := make([]string, 0, len(spec.Containers))
-	for i := range spec.Containers {
-		ii = append(ii, spec.Containers[i].Image)
+	return ExtractImageRefs(spec, false)
+}
+
+// ExtractImageRefs returns a collection of unique container image references.
+// When stripDigest is set, digests are dropped so pinned images group with their tags.
+func ExtractImageRefs(spec *v1.PodSpec, stripDigest bool) []string {
+	rr := ExtractImagePairs(spec)
+	ii := make([]string, 0, len(rr))
+	seen := make(map[string]struct{}, len(rr))
+	for _, r := range rr {
+		img := r.Image
+		if stripDigest {
+			img = StripDigest(img)
+		}
+		if _, ok := seen[img]; ok {
+			continue
+		}
+		seen[img] = struct{}{}
+		ii = append(ii, img)
 	}
 
 	return ii
 }
 
-func computeVulScore(ns string, lbls map[string]string, spec *v1.PodSpec) string {
+func skipVulScan(ann map[string]string) bool {
+	skip, err := strconv.ParseBool(ann[SkipVulScanAnnotation])
+
+	return err == nil && skip
+}
+
+// StripDigest removes a digest suffix from an image reference ie img:1.2@sha256:... -> img:1.2.
+func StripDigest(img string) string {
+	if i := strings.Index(img, "@"); i >= 0 {
+		return img[:i]
+	}
+
+	return img
+}
+
+// VulFormatter renders images vulnerability scans as a table cell.
+type VulFormatter interface {
+	// Format renders the given images scans.
+	Format(ss []*vul.Scan) string
+}
+
+type vulScoreFormatter struct{}
+
+// Format renders the worst images vulnerability score.
+func (vulScoreFormatter) Format(ss []*vul.Scan) string {
+	scores := make([]string, 0, len(ss))
+	for _, s := range ss {
+		scores = append(scores, s.Score())
+	}
+
+	return AggregateVulScore(scores)
+}
+
+// AggregateVulScore returns the worst of the given images scores.
+// See vul.WorstScore for the ranking rules.
+func AggregateVulScore(scores []string) string {
+	return vul.WorstScore(scores)
+}
+
+// VulFormat tracks the vulnerability cell formatter. Defaults to the worst image score.
+var VulFormat VulFormatter = vulScoreFormatter{}
+
+// SkipVulScanAnnotation opts a resource out of image vulnerability scans.
+const SkipVulScanAnnotation = "k9s.io/skip-vul-scan"
+
+func computeVulScore(ns string, lbls, ann map[string]string, spec *v1.PodSpec) string {
 	if vul.ImgScanner == nil || !vul.ImgScanner.IsInitialized() || vul.ImgScanner.ShouldExcludes(ns, lbls) {
 		return NAValue
 	}
+	if skipVulScan(ann) {
+		return NAValue
+	}
 	ii := ExtractImages(spec)
 	vul.ImgScanner.Enqueue(context.Background(), ii...)
-	sc := vul.ImgScanner.Score(ii...)
 
-	return sc
+	return VulFormat.Format(vul.ImgScanner.Scans(ii...))
 }
 
+// VulSeverityColors tracks the vulnerability score cell colors by worst severity.
+// Severities without a color are left to the row color.
+var VulSeverityColors = map[vul.Severity]string{
+	vul.SeverityCritical: "red",
+	vul.SeverityHigh:     "orange",
+	vul.SeverityMedium:   "yellow",
+}
+
+func toVulColor(s string) string {
+	c, ok := VulSeverityColors[vul.ScoreSeverity(s)]
+	if !ok {
+		return s
+	}
+
+	return "[" + c + "::]" + s + "[-::]"
+}
+
+// runesToNum converts digits to a number, skipping non digit runes.
+// Overflows saturate at math.MaxInt64.
 func runesToNum(rr []rune) int64 {
 	var r int64
-	var m int64 = 1
-	for i := len(rr) - 1; i >= 0; i-- {
-		v := int64(rr[i] - '0')
-		r += v * m
-		m *= 10
+	for _, c := range rr {
+		if !isDigit(c) {
+			continue
+		}
+		d := int64(c - '0')
+		if r > (math.MaxInt64-d)/10 {
+			return math.MaxInt64
+		}
+		r = r*10 + d
 	}
 
 	return r
 }
 
+// maxNumRunes tracks the longest digits run safely converted to an int64.
+const maxNumRunes = 18
+
+// NaturalLess compares strings comparing digits runs numerically and other runs lexically.
+// Naturally equal strings ie leading zeros are ordered lexically so sorting stays stable.
+func NaturalLess(a, b string) bool {
+	ra, rb := []rune(a), []rune(b)
+	for len(ra) > 0 && len(rb) > 0 {
+		if !isDigit(ra[0]) || !isDigit(rb[0]) {
+			if ra[0] != rb[0] {
+				return ra[0] < rb[0]
+			}
+			ra, rb = ra[1:], rb[1:]
+			continue
+		}
+		na, nb := digitsRun(ra), digitsRun(rb)
+		if c := compareDigits(ra[:na], rb[:nb]); c != 0 {
+			return c < 0
+		}
+		ra, rb = ra[na:], rb[nb:]
+	}
+	if len(ra) != len(rb) {
+		return len(ra) < len(rb)
+	}
+
+	return a < b
+}
+
+func isDigit(r rune) bool {
+	return r >= '0' && r <= '9'
+}
+
+func digitsRun(rr []rune) int {
+	var n int
+	for n < len(rr) && isDigit(rr[n]) {
+		n++
+	}
+
+	return n
+}
+
+func compareDigits(d1, d2 []rune) int {
+	d1, d2 = trimZeros(d1), trimZeros(d2)
+	switch {
+	case len(d1) != len(d2):
+		return len(d1) - len(d2)
+	case len(d1) <= maxNumRunes:
+		n1, n2 := runesToNum(d1), runesToNum(d2)
+		switch {
+		case n1 < n2:
+			return -1
+		case n1 > n2:
+			return 1
+		}
+		return 0
+	default:
+		return strings.Compare(string(d1), string(d2))
+	}
+}
+
+func trimZeros(rr []rune) []rune {
+	for len(rr) > 1 && rr[0] == '0' {
+		rr = rr[1:]
+	}
+
+	return rr
+}
+
+var (
+	numberPrinter atomic.Pointer[message.Printer]
+
+	// plainGrouping tracks whether the locale groups digits by 3 with commas.
+	plainGrouping atomic.Bool
+)
+
+func init() {
+	SetNumberLocale(language.English)
+}
+
+// SetNumberLocale sets the locale used to format numbers. Defaults to English.
+func SetNumberLocale(tag language.Tag) {
+	numberPrinter.Store(message.NewPrinter(tag))
+	plainGrouping.Store(tag == language.English || tag == language.AmericanEnglish)
+}
+
+var thousandsSep atomic.Value
+
+// SetThousandsSeparator sets a digits grouping separator ie ' overriding the
+// number locale. Blank restores locale grouping.
+func SetThousandsSeparator(sep string) {
+	thousandsSep.Store(sep)
+}
+
 // AsThousands prints a number with thousand separator.
 func AsThousands(n int64) string {
-	p := message.NewPrinter(language.English)
-	return p.Sprintf("%d", n)
+	if sep, _ := thousandsSep.Load().(string); sep != "" {
+		return groupDigits(n, sep)
+	}
+	if plainGrouping.Load() {
+		return groupThousands(n)
+	}
+
+	return numberPrinter.Load().Sprintf("%d", n)
+}
+
+// groupThousands groups digits with commas ie 1,234,567 bypassing the locale printer.
+func groupThousands(n int64) string {
+	return groupDigits(n, ",")
+}
+
+func groupDigits(n int64, sep string) string {
+	if n > -1000 && n < 1000 {
+		return strconv.FormatInt(n, 10)
+	}
+	var buf [20]byte
+	d := strconv.AppendInt(buf[:0], n, 10)
+	var b strings.Builder
+	b.Grow(len(d) + (len(d)-1)/3*len(sep))
+	if n < 0 {
+		b.WriteByte('-')
+		d = d[1:]
+	}
+	head := len(d) % 3
+	if head == 0 {
+		head = 3
+	}
+	b.Write(d[:head])
+	for i := head; i < len(d); i += 3 {
+		b.WriteString(sep)
+		b.Write(d[i : i+3])
+	}
+
+	return b.String()
 }
 
 // AsStatus returns error as string.
@@ -73,21 +295,53 @@ func AsStatus(err error) string {
 	return err.Error()
 }
 
+// maxSelectorErr tracks the max width of a selector conversion error.
+const maxSelectorErr = 40
+
+// asSelector renders a label selector with requirements in stable order.
+// Malformed selectors render a shortened error reason.
 func asSelector(s *metav1.LabelSelector) string {
 	sel, err := metav1.LabelSelectorAsSelector(s)
 	if err != nil {
 		slog.Error("Selector conversion failed", slogs.Error, err)
-		return NAValue
+		return Truncate("invalid: "+err.Error(), maxSelectorErr)
+	}
+	rr, _ := sel.Requirements()
+	ss := make([]string, 0, len(rr))
+	for _, r := range rr {
+		ss = append(ss, r.String())
 	}
+	sort.Strings(ss)
 
-	return sel.String()
+	return strings.Join(ss, ",")
+}
+
+// FormatSelectorWithCount renders a label selector with the count of pods it matches ie app=nginx (3 pods).
+// Invalid selectors still note the count.
+func FormatSelectorWithCount(sel *metav1.LabelSelector, matched int) string {
+	n := strconv.Itoa(matched) + " pods"
+	if matched == 1 {
+		n = "1 pod"
+	}
+	s := asSelector(sel)
+	if s == "" {
+		return "(" + n + ")"
+	}
+
+	return s + " (" + n + ")"
 }
 
-// ToSelector flattens a map selector to a string selector.
+// ToSelector flattens a map selector to a string selector sorted by keys.
+// Valid label keys and values can not contain commas or equal signs so no escaping is needed.
 func toSelector(m map[string]string) string {
-	s := make([]string, 0, len(m))
-	for k, v := range m {
-		s = append(s, k+"="+v)
+	if len(m) == 0 {
+		return ""
+	}
+
+	kk := sortedKeys(m)
+	s := make([]string, 0, len(kk))
+	for _, k := range kk {
+		s = append(s, k+"="+m[k])
 	}
 
 	return strings.Join(s, ",")
@@ -104,6 +358,260 @@ func blank(ss []string) bool {
 	return true
 }
 
+// joinKeep joins a slice of strings, keeping blanks in their slot.
+func joinKeep(ss []string, sep string) string {
+	return strings.Join(ss, sep)
+}
+
+// joinBounded joins a slice of strings, skipping blanks, within a max display width.
+// Items that do not fit are elided. A first item too wide on its own is truncated.
+func joinBounded(ss []string, sep string, maxWidth int) string {
+	s := join(ss, sep)
+	if runewidth.StringWidth(s) <= maxWidth {
+		return s
+	}
+
+	e, sw := Ellipsis(), runewidth.StringWidth(sep)
+	budget := maxWidth - runewidth.StringWidth(e)
+	var (
+		b     strings.Builder
+		w     int
+		first string
+	)
+	for _, item := range ss {
+		if item == "" {
+			continue
+		}
+		if first == "" {
+			first = item
+		}
+		iw := runewidth.StringWidth(item)
+		if b.Len() > 0 {
+			iw += sw
+		}
+		if w+iw+sw > budget {
+			break
+		}
+		if b.Len() > 0 {
+			b.WriteString(sep)
+		}
+		b.WriteString(item)
+		w += iw
+	}
+	if b.Len() == 0 {
+		// Nothing fits whole so show as much of the first item as possible.
+		return Truncate(first, maxWidth)
+	}
+
+	return b.String() + sep + e
+}
+
+// FormatOwner renders the controlling owner as kind/name ie Deployment/nginx or NAValue if none.
+// Falls back to the first owner when none is a controller, noting the others ie ReplicaSet/fred,+1.
+func FormatOwner(oo []metav1.OwnerReference) string {
+	if len(oo) == 0 {
+		return NAValue
+	}
+	o := oo[0]
+	for _, r := range oo {
+		if r.Controller != nil && *r.Controller {
+			o = r
+			break
+		}
+	}
+	s := o.Kind + "/" + o.Name
+	if len(oo) > 1 {
+		s += ",+" + strconv.Itoa(len(oo)-1)
+	}
+
+	return s
+}
+
+// FormatEnvFrom renders env sources as sorted kind:name items ie cm:foo,secret:bar or NAValue if none.
+// Prefixed sources note their prefix ie cm:foo(APP_).
+func FormatEnvFrom(ee []v1.EnvFromSource) string {
+	ss := make([]string, 0, len(ee))
+	for _, e := range ee {
+		var s string
+		switch {
+		case e.ConfigMapRef != nil:
+			s = "cm:" + e.ConfigMapRef.Name
+		case e.SecretRef != nil:
+			s = "secret:" + e.SecretRef.Name
+		default:
+			continue
+		}
+		if e.Prefix != "" {
+			s += "(" + e.Prefix + ")"
+		}
+		ss = append(ss, s)
+	}
+	if len(ss) == 0 {
+		return NAValue
+	}
+	sort.Strings(ss)
+
+	return join(ss, ",")
+}
+
+// maxFinalizersWidth tracks the max display width of a finalizers list.
+const maxFinalizersWidth = 50
+
+// FormatFinalizers renders sorted finalizers or MissingValue if none. Lists too wide
+// are elided, noting how many were left out ie a,b,…(+2).
+func FormatFinalizers(ff []string) string {
+	ss := make([]string, 0, len(ff))
+	for _, f := range ff {
+		if f != "" {
+			ss = append(ss, f)
+		}
+	}
+	if len(ss) == 0 {
+		return MissingValue
+	}
+	sort.Strings(ss)
+	if s := join(ss, ","); runewidth.StringWidth(s) <= maxFinalizersWidth {
+		return s
+	}
+	for n := len(ss) - 1; n > 0; n-- {
+		more := fmt.Sprintf(",%s(+%d)", Ellipsis(), len(ss)-n)
+		head := join(ss[:n], ",")
+		if n == 1 {
+			head = Truncate(head, maxFinalizersWidth-runewidth.StringWidth(more))
+		}
+		if s := head + more; n == 1 || runewidth.StringWidth(s) <= maxFinalizersWidth {
+			return s
+		}
+	}
+
+	return Truncate(ss[0], maxFinalizersWidth)
+}
+
+// FormatTaints renders taints as sorted key=value:effect items or MissingValue if none.
+func FormatTaints(tt []v1.Taint) string {
+	if len(tt) == 0 {
+		return MissingValue
+	}
+	ss := make([]string, 0, len(tt))
+	for _, t := range tt {
+		s := t.Key
+		if t.Value != "" {
+			s += "=" + t.Value
+		}
+		ss = append(ss, s+":"+string(t.Effect))
+	}
+	sort.Strings(ss)
+
+	return join(ss, ",")
+}
+
+// FormatTolerations renders tolerations as sorted key=value:effect items or MissingValue if none.
+// Exists tolerations omit the value, and a blank key or effect matches all as *.
+func FormatTolerations(tt []v1.Toleration) string {
+	if len(tt) == 0 {
+		return MissingValue
+	}
+	ss := make([]string, 0, len(tt))
+	for _, t := range tt {
+		s := t.Key
+		if s == "" {
+			s = "*"
+		}
+		if t.Operator != v1.TolerationOpExists && t.Value != "" {
+			s += "=" + t.Value
+		}
+		if t.Effect != "" {
+			s += ":" + string(t.Effect)
+		}
+		if t.TolerationSeconds != nil {
+			s += "(" + strconv.FormatInt(*t.TolerationSeconds, 10) + "s)"
+		}
+		ss = append(ss, s)
+	}
+	sort.Strings(ss)
+
+	return join(ss, ",")
+}
+
+type namedPort struct {
+	name  string
+	port  int32
+	proto v1.Protocol
+}
+
+// FormatPorts renders container ports sorted by number ie 80/TCP,443/TCP or NAValue if none.
+// Port names are included when named is set ie http:80/TCP.
+func FormatPorts(pp []v1.ContainerPort, named bool) string {
+	nn := make([]namedPort, 0, len(pp))
+	for _, p := range pp {
+		nn = append(nn, namedPort{name: p.Name, port: p.ContainerPort, proto: p.Protocol})
+	}
+
+	return formatPorts(nn, named)
+}
+
+// FormatServicePorts renders service ports sorted by number ie 80/TCP,443/TCP or NAValue if none.
+// Port names are included when named is set ie http:80/TCP.
+func FormatServicePorts(pp []v1.ServicePort, named bool) string {
+	nn := make([]namedPort, 0, len(pp))
+	for _, p := range pp {
+		nn = append(nn, namedPort{name: p.Name, port: p.Port, proto: p.Protocol})
+	}
+
+	return formatPorts(nn, named)
+}
+
+func formatPorts(nn []namedPort, named bool) string {
+	if len(nn) == 0 {
+		return NAValue
+	}
+	sort.SliceStable(nn, func(i, j int) bool {
+		if nn[i].port != nn[j].port {
+			return nn[i].port < nn[j].port
+		}
+		return nn[i].proto < nn[j].proto
+	})
+	ss := make([]string, 0, len(nn))
+	for _, p := range nn {
+		proto := p.proto
+		if proto == "" {
+			proto = v1.ProtocolTCP
+		}
+		s := strconv.Itoa(int(p.port)) + "/" + string(proto)
+		if named && p.name != "" {
+			s = p.name + ":" + s
+		}
+		ss = append(ss, s)
+	}
+
+	return join(ss, ",")
+}
+
+// FormatIPs joins ip addresses, skipping blanks, within a max display width.
+// When too wide, the first address is shown with a count of the ones left out ie 10.0.0.1,+1.
+func FormatIPs(ips []string, maxWidth int) string {
+	s := join(ips, ",")
+	if maxWidth <= 0 || runewidth.StringWidth(s) <= maxWidth {
+		return s
+	}
+	ii := make([]string, 0, len(ips))
+	for _, ip := range ips {
+		if ip != "" {
+			ii = append(ii, ip)
+		}
+	}
+	if len(ii) == 1 {
+		return Truncate(ii[0], maxWidth)
+	}
+	more := ",+" + strconv.Itoa(len(ii)-1)
+	budget := maxWidth - runewidth.StringWidth(more)
+	if budget <= 0 {
+		return Truncate(more[1:], maxWidth)
+	}
+
+	return Truncate(ii[0], budget) + more
+}
+
 // Join a slice of strings, skipping blanks.
 func join(ss []string, sep string) string {
 	switch len(ss) {
@@ -127,165 +635,819 @@ func join(ss []string, sep string) string {
 	for i := range b {
 		n += len(ss[i])
 	}
-
-	var buff strings.Builder
-	buff.Grow(n)
-	buff.WriteString(b[0])
-	for _, s := range b[1:] {
-		buff.WriteString(sep)
-		buff.WriteString(s)
+
+	var buff strings.Builder
+	buff.Grow(n)
+	buff.WriteString(b[0])
+	for _, s := range b[1:] {
+		buff.WriteString(sep)
+		buff.WriteString(s)
+	}
+
+	return buff.String()
+}
+
+// AsPerc prints a number as percentage with parens.
+// Already wrapped or non numeric inputs are returned unchanged.
+func AsPerc(p string) string {
+	if strings.HasPrefix(p, "(") && strings.HasSuffix(p, ")") {
+		return p
+	}
+	n := strings.TrimSuffix(p, "%")
+	if _, err := strconv.ParseFloat(n, 64); err != nil {
+		return p
+	}
+
+	return "(" + n + "%)"
+}
+
+// PrintPerc prints a number as percentage.
+func PrintPerc(p int) string {
+	return strconv.Itoa(p) + "%"
+}
+
+// ClampPercents tracks whether percentages display within 0-100%.
+// Off by default so bursting over limits remains visible.
+var ClampPercents bool
+
+// PrintPercClamped prints a percentage, bound to 0-100% when clamping is on.
+func PrintPercClamped(p float64) string {
+	if ClampPercents {
+		return PrintPerc(clampPct(p))
+	}
+
+	return PrintPerc(int(math.Round(p)))
+}
+
+func clampPct(p float64) int {
+	return int(math.Round(math.Min(math.Max(p, 0), 100)))
+}
+
+// Percentage bar glyphs. Override ie #/- for ascii only terminals.
+var (
+	BarFullGlyph  = "▇"
+	BarEmptyGlyph = "░"
+)
+
+// PercBar renders a percentage as a bar of the given cells count followed by
+// its value ie ▇▇▇░░ 60%. Percentages are bound to 0-100%.
+func PercBar(p, cells int) string {
+	p = clampPct(float64(p))
+	cells = max(cells, 0)
+	full := int(math.Round(float64(p*cells) / 100))
+
+	return strings.Repeat(BarFullGlyph, full) + strings.Repeat(BarEmptyGlyph, cells-full) + " " + PrintPerc(p)
+}
+
+// PrintPercF prints a number as percentage with one decimal ie 0.5%.
+func PrintPercF(p float64) string {
+	return strings.TrimSuffix(strconv.FormatFloat(p, 'f', 1, 64), ".0") + "%"
+}
+
+// IntToStr converts an int to a string.
+func IntToStr(p int) string {
+	return strconv.Itoa(p)
+}
+
+func readyCount(ss []v1.ContainerStatus) int {
+	var n int
+	for i := range ss {
+		if ss[i].Ready {
+			n++
+		}
+	}
+
+	return n
+}
+
+// ReadySummary returns containers readiness as ready/total ie 1/2.
+func ReadySummary(ss []v1.ContainerStatus) string {
+	return join([]string{IntToStr(readyCount(ss)), IntToStr(len(ss))}, "/")
+}
+
+// ReadyBucket returns a coloring bucket for containers readiness.
+// All ready is low, partially ready is high and none ready is critical.
+func ReadyBucket(ss []v1.ContainerStatus) Bucket {
+	n := readyCount(ss)
+	switch {
+	case len(ss) == 0:
+		return BucketUnknown
+	case n == len(ss):
+		return BucketLow
+	case n == 0:
+		return BucketCritical
+	default:
+		return BucketHigh
+	}
+}
+
+// Empty cells placeholders. Override to customize blank cells rendering.
+var (
+	MissingPlaceholder = MissingValue
+	NAPlaceholder      = NAValue
+)
+
+func missing(s string) string {
+	return check(s, MissingPlaceholder)
+}
+
+func naStrings(ss []string) string {
+	if len(ss) == 0 {
+		return NAPlaceholder
+	}
+	return strings.Join(ss, ",")
+}
+
+func na(s string) string {
+	return check(s, NAPlaceholder)
+}
+
+// FormatCondition renders the named condition status and reason ie True (MinimumReplicasAvailable).
+func FormatCondition(cc []metav1.Condition, condType string) string {
+	for _, c := range cc {
+		if c.Type != condType {
+			continue
+		}
+		s := check(string(c.Status), UnknownValue)
+		if c.Reason == "" {
+			return s
+		}
+
+		return s + " (" + c.Reason + ")"
+	}
+
+	return na("")
+}
+
+func check(s, sub string) string {
+	if s == "" {
+		return sub
+	}
+
+	return s
+}
+
+func boolToStr(b bool) string {
+	switch b {
+	case true:
+		return "true"
+	default:
+		return "false"
+	}
+}
+
+// Boolean glyphs. Override ie Y/N for ascii only terminals.
+var (
+	TrueGlyph  = "✓"
+	FalseGlyph = "✗"
+)
+
+func boolToGlyph(b bool) string {
+	if b {
+		return TrueGlyph
+	}
+
+	return FalseGlyph
+}
+
+// parseBoolish parses the usual boolean spellings ie True, 1, yes or off.
+func parseBoolish(s string) (bool, bool) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "true", "t", "1", "yes", "y", "on":
+		return true, true
+	case "false", "f", "0", "no", "n", "off":
+		return false, true
+	default:
+		return false, false
+	}
+}
+
+// NormalizeBool canonicalizes boolean strings ie True, 1 or yes to true or false.
+// Unrecognized values are returned as is.
+func NormalizeBool(s string) string {
+	if b, ok := parseBoolish(s); ok {
+		return boolToStr(b)
+	}
+
+	return s
+}
+
+// NormalizeBoolGlyph renders boolean strings as glyphs ie 1 as ✓.
+// Unrecognized values are returned as is.
+func NormalizeBoolGlyph(s string) string {
+	if b, ok := parseBoolish(s); ok {
+		return boolToGlyph(b)
+	}
+
+	return s
+}
+
+// ToAge converts time to human duration.
+func ToAge(t metav1.Time) string {
+	if t.IsZero() {
+		return UnknownValue
+	}
+	if AbsoluteAge {
+		return ToAgeAbsolute(t)
+	}
+
+	return toAge(t.Time)
+}
+
+// StaleThreshold tracks how long data may go without refresh before being marked stale.
+// Zero disables stale markers.
+var StaleThreshold = 2 * time.Minute
+
+// StaleMarker tracks the suffix flagging stale ages.
+var StaleMarker = "*"
+
+// IsStale checks if data last refreshed at t is older than the given threshold.
+// Unknown refresh times or non positive thresholds are never stale.
+func IsStale(t metav1.Time, threshold time.Duration) bool {
+	if t.IsZero() || threshold <= 0 {
+		return false
+	}
+
+	return time.Since(t.Time) > threshold
+}
+
+// ToAgeStale converts time to human duration, flagged with StaleMarker when
+// the data last refreshed at refreshed exceeds StaleThreshold.
+func ToAgeStale(t, refreshed metav1.Time) string {
+	age := ToAge(t)
+	if IsStale(refreshed, StaleThreshold) {
+		return age + StaleMarker
+	}
+
+	return age
+}
+
+// DefaultAgeFormat tracks the default absolute age timestamp layout.
+const DefaultAgeFormat = "2006-01-02 15:04"
+
+// AbsoluteAge tracks whether ages render as local timestamps vs relative durations.
+var AbsoluteAge bool
+
+// AgeFormat tracks the absolute age timestamp layout.
+var AgeFormat = DefaultAgeFormat
+
+// ToAgeAbsolute returns a local timestamp for a given time.
+func ToAgeAbsolute(t metav1.Time) string {
+	if t.IsZero() {
+		return UnknownValue
+	}
+	f := AgeFormat
+	if f == "" {
+		f = DefaultAgeFormat
+	}
+
+	return t.Local().Format(f)
+}
+
+// ToDuration returns a human duration elapsed between two times ie a job run time.
+func ToDuration(start, end metav1.Time) string {
+	if start.IsZero() || end.IsZero() {
+		return UnknownValue
+	}
+	if end.Before(&start) {
+		return NAValue
+	}
+
+	return duration.HumanDuration(end.Sub(start.Time))
+}
+
+// ToAgeCompact returns a duration since a given time using its largest unit only ie 3d.
+func ToAgeCompact(t metav1.Time) string {
+	if t.IsZero() {
+		return UnknownValue
+	}
+
+	return compactDuration(time.Since(t.Time))
+}
+
+// ToExpiry returns the time left until a deadline ie in 3d or since it passed ie expired 2h ago.
+func ToExpiry(t metav1.Time) string {
+	if t.IsZero() {
+		return UnknownValue
+	}
+	d := time.Until(t.Time)
+	if d < 0 {
+		return "expired " + duration.HumanDuration(-d) + " ago"
+	}
+
+	return "in " + duration.HumanDuration(d)
+}
+
+// SecondsToHuman renders a seconds count ie a probe timeout as a compact duration ie 1m30s.
+// Only the two largest units are shown, omitting a zero trailing unit ie 2h.
+func SecondsToHuman(sec int64) string {
+	switch {
+	case sec < 0:
+		return NAValue
+	case sec == 0:
+		return ZeroValue
+	}
+
+	const (
+		minute = 60
+		hour   = 60 * minute
+		day    = 24 * hour
+	)
+	for _, u := range []struct {
+		size, nextSize int64
+		unit, next     string
+	}{
+		{day, hour, "d", "h"},
+		{hour, minute, "h", "m"},
+		{minute, 1, "m", "s"},
+	} {
+		if sec < u.size {
+			continue
+		}
+		s := strconv.FormatInt(sec/u.size, 10) + u.unit
+		if r := sec % u.size / u.nextSize; r > 0 {
+			s += strconv.FormatInt(r, 10) + u.next
+		}
+		return s
+	}
+
+	return strconv.FormatInt(sec, 10) + "s"
+}
+
+// RestartInfo renders a restart count with the last restart recency ie 5 (2m ago).
+// The recency is omitted when there are no restarts or the last restart time is unknown.
+func RestartInfo(count int32, lastRestart metav1.Time) string {
+	n := strconv.Itoa(int(count))
+	if count == 0 || lastRestart.IsZero() {
+		return n
+	}
+
+	return n + " (" + duration.HumanDuration(time.Since(lastRestart.Time)) + " ago)"
+}
+
+// ToAgeFixed returns a compact age right aligned within the given display width ie "  3d".
+func ToAgeFixed(t metav1.Time, width int) string {
+	return PadRight(ToAgeCompact(t), width)
+}
+
+func compactDuration(d time.Duration) string {
+	if d < 0 {
+		d = 0
+	}
+	const day = 24 * time.Hour
+	switch {
+	case d < time.Minute:
+		return fmt.Sprintf("%ds", d/time.Second)
+	case d < time.Hour:
+		return fmt.Sprintf("%dm", d/time.Minute)
+	case d < day:
+		return fmt.Sprintf("%dh", d/time.Hour)
+	case d < 365*day:
+		return fmt.Sprintf("%dd", d/day)
+	default:
+		return fmt.Sprintf("%dy", d/(365*day))
+	}
+}
+
+func toAgeHuman(s string) string {
+	if s == "" {
+		return UnknownValue
+	}
+
+	t, ok := parseTimestamp(s)
+	if !ok {
+		return NAValue
+	}
+	if AbsoluteAge {
+		return ToAgeAbsolute(metav1.Time{Time: t})
+	}
+
+	return toAge(t)
+}
+
+// timestampLayouts tracks the timestamp layouts typically found in resources status.
+var timestampLayouts = []string{
+	time.RFC3339Nano,
+	time.RFC3339,
+	metav1.RFC3339Micro,
+	"2006-01-02T15:04:05",
+}
+
+// parseTimestamp parses a timestamp trying known layouts in order.
+// Timestamps without a zone are assumed to be local.
+func parseTimestamp(s string) (time.Time, bool) {
+	for _, l := range timestampLayouts {
+		if t, err := time.ParseInLocation(l, s, time.Local); err == nil {
+			return t, true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+var ellipsis atomic.Value
+
+// SetEllipsis sets the truncation marker ie ... for terminals lacking the semigraphic ellipsis.
+func SetEllipsis(e string) {
+	ellipsis.Store(e)
+}
+
+// Ellipsis returns the current truncation marker.
+func Ellipsis() string {
+	if e, ok := ellipsis.Load().(string); ok {
+		return e
+	}
+
+	return string(tview.SemigraphicsHorizontalEllipsis)
+}
+
+// Truncate a string to the given l and suffix ellipsis if needed.
+func Truncate(str string, width int) string {
+	e, ok := fitEllipsis(str, width)
+	if !ok {
+		return e
+	}
+
+	return runewidth.Truncate(str, width, e)
+}
+
+// TruncateN truncates a string like Truncate, also returning how many display
+// cells of the original string were hidden ie to render name… (+12).
+func TruncateN(str string, width int) (string, int) {
+	w := runewidth.StringWidth(str)
+	if w <= width {
+		return str, 0
+	}
+	s, e := Truncate(str, width), Ellipsis()
+	if runewidth.StringWidth(e) >= width {
+		// Only a clipped ellipsis fits.
+		return s, w
+	}
+
+	return s, w - runewidth.StringWidth(strings.TrimSuffix(s, e))
+}
+
+// TruncateLeft a string to the given display width, eliding its head ie …repo/app:v1.
+func TruncateLeft(str string, width int) string {
+	e, ok := fitEllipsis(str, width)
+	if !ok {
+		return e
+	}
+
+	return e + tail(str, width-runewidth.StringWidth(e))
+}
+
+// TruncateMiddle a string to the given display width, eliding its center.
+func TruncateMiddle(str string, width int) string {
+	e, ok := fitEllipsis(str, width)
+	if !ok {
+		return e
+	}
+	w := width - runewidth.StringWidth(e)
+	tw := w / 2
+
+	return runewidth.Truncate(str, w-tw, "") + e + tail(str, tw)
+}
+
+// ShortenFQN abbreviates a dotted name to the given display width, keeping its
+// leading segment and as many trailing segments as fit ie foo.….example.com.
+// Names too narrow to keep whole segments are middle truncated.
+func ShortenFQN(name string, width int) string {
+	e, ok := fitEllipsis(name, width)
+	if !ok {
+		return e
+	}
+	ss := strings.Split(name, ".")
+	if len(ss) < 3 {
+		return TruncateMiddle(name, width)
+	}
+	head := ss[0] + "." + e
+	w := runewidth.StringWidth(head)
+	var tail string
+	for i := len(ss) - 1; i > 0; i-- {
+		sw := runewidth.StringWidth(ss[i]) + 1
+		if w+sw > width {
+			break
+		}
+		tail, w = "."+ss[i]+tail, w+sw
+	}
+	if tail == "" {
+		return TruncateMiddle(name, width)
+	}
+
+	return head + tail
+}
+
+// fitEllipsis returns the ellipsis when a string needs truncating. Otherwise
+// returns the final result ie the string itself or a clipped ellipsis when width is too narrow.
+func fitEllipsis(str string, width int) (string, bool) {
+	if runewidth.StringWidth(str) <= width {
+		return str, false
+	}
+	if width <= 0 {
+		return "", false
+	}
+	e := Ellipsis()
+	if runewidth.StringWidth(e) >= width {
+		return runewidth.Truncate(e, width, ""), false
+	}
+
+	return e, true
+}
+
+// tail returns the longest suffix fitting in the given display width.
+// Grapheme clusters ie combining marks or flags are never split.
+func tail(str string, width int) string {
+	var cc []string
+	g := uniseg.NewGraphemes(str)
+	for g.Next() {
+		cc = append(cc, g.Str())
+	}
+	i, w := len(cc), 0
+	for i > 0 {
+		cw := runewidth.StringWidth(cc[i-1])
+		if w+cw > width {
+			break
+		}
+		w += cw
+		i--
+	}
+
+	return strings.Join(cc[i:], "")
+}
+
+// WrapWidth wraps a string in lines of at most width display cells, breaking on
+// spaces when possible. Words wider than width are hard broken between grapheme clusters.
+func WrapWidth(s string, width int) []string {
+	if width <= 0 || runewidth.StringWidth(s) <= width {
+		return []string{s}
+	}
+
+	var (
+		ll   []string
+		line strings.Builder
+		lw   int
+	)
+	flush := func() {
+		ll = append(ll, line.String())
+		line.Reset()
+		lw = 0
+	}
+	for _, word := range strings.Fields(s) {
+		ww := runewidth.StringWidth(word)
+		if lw > 0 && lw+1+ww <= width {
+			line.WriteString(" " + word)
+			lw += 1 + ww
+			continue
+		}
+		if lw > 0 {
+			flush()
+		}
+		if ww <= width {
+			line.WriteString(word)
+			lw = ww
+			continue
+		}
+		g := uniseg.NewGraphemes(word)
+		for g.Next() {
+			cw := runewidth.StringWidth(g.Str())
+			if lw > 0 && lw+cw > width {
+				flush()
+			}
+			line.WriteString(g.Str())
+			lw += cw
+		}
+	}
+	if lw > 0 {
+		flush()
 	}
 
-	return buff.String()
+	return ll
 }
 
-// AsPerc prints a number as percentage with parens.
-func AsPerc(p string) string {
-	return "(" + p + ")"
+func mapToStr(m map[string]string) string {
+	return mapToStrSep(m, "=", ",")
 }
 
-// PrintPerc prints a number as percentage.
-func PrintPerc(p int) string {
-	return strconv.Itoa(p) + "%"
-}
+// boundedLabels renders sorted labels within a max display width.
+func boundedLabels(m map[string]string, maxWidth int) string {
+	kk := sortedKeys(m)
+	ss := make([]string, 0, len(kk))
+	for _, k := range kk {
+		ss = append(ss, k+"="+m[k])
+	}
 
-// IntToStr converts an int to a string.
-func IntToStr(p int) string {
-	return strconv.Itoa(p)
+	return joinBounded(ss, ",", maxWidth)
 }
 
-func missing(s string) string {
-	return check(s, MissingValue)
-}
+// mapToStrSep renders sorted key/value pairs using the given separators.
+func mapToStrSep(m map[string]string, kvSep, pairSep string) string {
+	if len(m) == 0 {
+		return ""
+	}
 
-func naStrings(ss []string) string {
-	if len(ss) == 0 {
-		return NAValue
+	kk := sortedKeys(m)
+	bb := make([]byte, 0, 100)
+	for i, k := range kk {
+		bb = append(bb, k+kvSep+m[k]...)
+		if i < len(kk)-1 {
+			bb = append(bb, pairSep...)
+		}
 	}
-	return strings.Join(ss, ",")
-}
 
-func na(s string) string {
-	return check(s, NAValue)
+	return string(bb)
 }
 
-func check(s, sub string) string {
-	if s == "" {
-		return sub
+// mapToStrN renders up to maxPairs sorted pairs, noting how many were omitted.
+// A non positive maxPairs renders all pairs.
+func mapToStrN(m map[string]string, maxPairs int) string {
+	if maxPairs <= 0 || maxPairs >= len(m) {
+		return mapToStr(m)
 	}
 
-	return s
+	kk := sortedKeys(m)
+	sub := make(map[string]string, maxPairs)
+	for _, k := range kk[:maxPairs] {
+		sub[k] = m[k]
+	}
+
+	return mapToStr(sub) + fmt.Sprintf(",%s(+%d more)", Ellipsis(), len(kk)-maxPairs)
 }
 
-func boolToStr(b bool) string {
-	switch b {
-	case true:
-		return "true"
-	default:
-		return "false"
+// FilterLabels returns the labels whose keys match any of the given prefixes when
+// include is set, or the ones matching none otherwise.
+func FilterLabels(m map[string]string, prefixes []string, include bool) map[string]string {
+	mm := make(map[string]string, len(m))
+	for k, v := range m {
+		if hasAnyPrefix(k, prefixes) == include {
+			mm[k] = v
+		}
 	}
+
+	return mm
 }
 
-// ToAge converts time to human duration.
-func ToAge(t metav1.Time) string {
-	if t.IsZero() {
-		return UnknownValue
+func hasAnyPrefix(s string, prefixes []string) bool {
+	for _, p := range prefixes {
+		if strings.HasPrefix(s, p) {
+			return true
+		}
 	}
 
-	return duration.HumanDuration(time.Since(t.Time))
+	return false
 }
 
-func toAgeHuman(s string) string {
-	if s == "" {
-		return UnknownValue
-	}
+// ValueMask tracks the placeholder substituted for sensitive values.
+var ValueMask = "••••"
 
-	t, err := time.Parse(time.RFC3339, s)
-	if err != nil {
-		return NAValue
+// MaskValues returns a copy of a map with all values replaced by ValueMask.
+func MaskValues(m map[string]string) map[string]string {
+	mm := make(map[string]string, len(m))
+	for k := range m {
+		mm[k] = ValueMask
 	}
 
-	return duration.HumanDuration(time.Since(t))
+	return mm
 }
 
-// Truncate a string to the given l and suffix ellipsis if needed.
-func Truncate(str string, width int) string {
-	return runewidth.Truncate(str, width, string(tview.SemigraphicsHorizontalEllipsis))
+// mapToStrMasked renders sorted keys with masked values ie password=••••.
+func mapToStrMasked(m map[string]string) string {
+	return mapToStr(MaskValues(m))
 }
 
-func mapToStr(m map[string]string) string {
-	if len(m) == 0 {
-		return ""
-	}
-
+func sortedKeys(m map[string]string) []string {
 	kk := make([]string, 0, len(m))
 	for k := range m {
 		kk = append(kk, k)
 	}
 	sort.Strings(kk)
 
-	bb := make([]byte, 0, 100)
-	for i, k := range kk {
-		bb = append(bb, k+"="+m[k]...)
-		if i < len(kk)-1 {
-			bb = append(bb, ',')
-		}
-	}
-
-	return string(bb)
+	return kk
 }
 
-func mapToIfc(m any) (s string) {
-	if m == nil {
-		return ""
-	}
-
+func mapToIfc(m any) string {
 	mm, ok := m.(map[string]any)
 	if !ok {
 		return ""
 	}
-	if len(mm) == 0 {
+
+	ss := make(map[string]string, len(mm))
+	for k, v := range mm {
+		ss[k] = ifcToStr(v)
+	}
+
+	return mapToStrSep(ss, "=", " ")
+}
+
+// ifcToStr renders scalars as is and nested values as compact json.
+func ifcToStr(v any) string {
+	switch t := v.(type) {
+	case nil:
 		return ""
+	case string:
+		return t
+	case map[string]any, []any:
+		bb, err := json.Marshal(t)
+		if err != nil {
+			return fmt.Sprintf("%v", t)
+		}
+		return string(bb)
+	default:
+		return fmt.Sprintf("%v", t)
 	}
+}
 
-	kk := make([]string, 0, len(mm))
-	for k := range mm {
-		kk = append(kk, k)
+func toTemplateHash(ll map[string]string) string {
+	return na(ll[appsv1.DefaultDeploymentUniqueLabelKey])
+}
+
+// toHashColor tints a revision hash using the string palette.
+func toHashColor(h string) string {
+	if h == "" || h == NAValue {
+		return h
+	}
+	c := ColorForString(h)
+	if c == tcell.ColorDefault {
+		return h
 	}
-	sort.Strings(kk)
 
-	for i, k := range kk {
-		str, ok := mm[k].(string)
-		if !ok {
-			continue
-		}
-		s += k + "=" + str
-		if i < len(kk)-1 {
-			s += " "
-		}
+	return fmt.Sprintf("[#%06x::]%s[-::]", c.Hex(), h)
+}
+
+// StringPalette tracks the colors used to tint cells by value ie revision hashes.
+var StringPalette = []tcell.Color{
+	tcell.ColorAqua,
+	tcell.ColorFuchsia,
+	tcell.ColorOrange,
+	tcell.ColorLime,
+	tcell.ColorYellow,
+	tcell.ColorDodgerBlue,
+	tcell.ColorViolet,
+	tcell.ColorSpringGreen,
+}
+
+// ColorForString returns a stable palette color for a given string ie to group rows by app.
+func ColorForString(s string) tcell.Color {
+	if len(StringPalette) == 0 {
+		return tcell.ColorDefault
 	}
 
-	return
+	return StringPalette[hashIndex(s, len(StringPalette))]
+}
+
+func hashIndex(s string, n int) uint32 {
+	f := fnv.New32a()
+	_, _ = f.Write([]byte(s))
+
+	return f.Sum32() % uint32(n)
 }
 
+// toMu renders unit counts ie GPUs. Unlike toMc and toMi, zero renders blank
+// since a zero count means the resource is not available rather than idle.
 func toMu(v int64) string {
+	return toMuOr(v, NAValue)
+}
+
+// toMuOr renders unit counts using the given placeholder for zero.
+func toMuOr(v int64, zero string) string {
 	if v == 0 {
-		return NAValue
+		return zero
 	}
 
 	return strconv.Itoa(int(v))
 }
 
+// toMc renders millicores, zero rendering as ZeroValue.
 func toMc(v int64) string {
+	return toMcOr(v, ZeroValue)
+}
+
+// toMcOr renders millicores using the given placeholder for zero.
+func toMcOr(v int64, zero string) string {
 	if v == 0 {
-		return ZeroValue
+		return zero
 	}
 	return strconv.Itoa(int(v))
 }
 
+func mxcToStr(v int64) string {
+	u := CPUUnit()
+	if u == "" {
+		return toMc(v)
+	}
+
+	return FormatCPU(v, u)
+}
+
+// toMi renders bytes as MiB, zero rendering as ZeroValue.
 func toMi(v int64) string {
+	return toMiOr(v, ZeroValue)
+}
+
+// toMiOr renders bytes as MiB using the given placeholder for zero.
+func toMiOr(v int64, zero string) string {
 	if v == 0 {
-		return ZeroValue
+		return zero
 	}
 	return strconv.Itoa(int(client.ToMB(v)))
 }
@@ -298,7 +1460,8 @@ func humanateBytes(s uint64, base float64, sizes []string) string {
 	if s < 10 {
 		return fmt.Sprintf("%d B", s)
 	}
-	e := math.Floor(logn(float64(s), base))
+	// Clamp to the largest suffix so huge values never overrun sizes.
+	e := math.Min(math.Floor(logn(float64(s), base)), float64(len(sizes)-1))
 	suffix := sizes[int(e)]
 	val := math.Floor(float64(s)/math.Pow(base, e)*10+0.5) / 10
 	f := "%.0f"
@@ -312,17 +1475,95 @@ func humanateBytes(s uint64, base float64, sizes []string) string {
 	return valStr + suffix
 }
 
+// SIBytes tracks whether byte sizes render using base 1000 SI units.
+var SIBytes bool
+
 func humanizeBytes(v int64) string {
-	if v == 0 {
-		return ZeroValue
+	if SIBytes {
+		return humanizeBytesSI(v)
 	}
 	sizes := []string{"", "K", "M", "G", "T", "P", "E"}
-	return humanateBytes(uint64(v), 1024, sizes)
+	return humanateSignedBytes(v, 1024, sizes)
+}
+
+// humanizeBytesSI renders bytes using base 1000 SI units ie KB, MB, GB...
+func humanizeBytesSI(v int64) string {
+	sizes := []string{" B", "KB", "MB", "GB", "TB", "PB", "EB"}
+	return humanateSignedBytes(v, 1000, sizes)
+}
+
+// BytesPerSec renders a throughput for bytes transferred over a duration ie 1.2M/s.
+func BytesPerSec(bytes int64, over time.Duration) string {
+	if over <= 0 {
+		return NAValue
+	}
+
+	return humanizeBytes(int64(float64(bytes)/over.Seconds())) + "/s"
+}
+
+// humanizeCount renders large counts with base 1000 suffixes ie 12k, 1.2M.
+func humanizeCount(n int64) string {
+	if n > -1000 && n < 1000 {
+		return strconv.FormatInt(n, 10)
+	}
+	sizes := []string{"", "k", "M", "G", "T", "P", "E"}
+	if n < 0 {
+		return "-" + humanateBytes(uint64(-(n+1))+1, 1000, sizes)
+	}
+
+	return humanateBytes(uint64(n), 1000, sizes)
+}
+
+// humanateSignedBytes renders negative sizes ie deltas with a leading minus sign.
+func humanateSignedBytes(v int64, base float64, sizes []string) string {
+	switch {
+	case v == 0:
+		return ZeroValue
+	case v < 0:
+		return "-" + humanateBytes(uint64(-(v+1))+1, base, sizes)
+	default:
+		return humanateBytes(uint64(v), base, sizes)
+	}
 }
 
 func memPct(v, l int64) string {
+	return bytesPct(v, l)
+}
+
+// memPctBucket returns a memory utilization and its severity bucket.
+func memPctBucket(v, l int64) (string, Bucket) {
+	return bytesPctBucket(v, l)
+}
+
+// memReqLim returns memory usage against both its request and limit ie 512M/256M req(200%)/1G lim(50%).
+// Unset requests or limits are omitted.
+func memReqLim(used, req, lim int64) string {
+	s := humanizeBytes(used)
+	for _, b := range []struct {
+		v     int64
+		label string
+	}{
+		{req, "req"},
+		{lim, "lim"},
+	} {
+		if b.v <= 0 {
+			continue
+		}
+		s += fmt.Sprintf("/%s %s(%.0f%%)", humanizeBytes(b.v), b.label, float64(used)/float64(b.v)*100)
+	}
+
+	return s
+}
+
+// bytesPct returns a bytes utilization against a limit ie memory or storage.
+func bytesPct(v, l int64) string {
+	s, _ := bytesPctBucket(v, l)
+	return s
+}
+
+func bytesPctBucket(v, l int64) (string, Bucket) {
 	if l <= 0 {
-		return humanizeBytes(v)
+		return humanizeBytes(v), BucketUnknown
 	}
 
 	vStr := humanizeBytes(v)
@@ -330,31 +1571,100 @@ func memPct(v, l int64) string {
 	pct := float64(v) / float64(l) * 100
 	pctStr := fmt.Sprintf("(%.0f%%)", pct)
 
-	return vStr + "/" + lStr + pctStr
+	return vStr + "/" + lStr + pctStr, ToBucket(pct)
 }
 
 func decimal(v int64) string {
-	vf := float64(v) / 1e3
+	return decimalCores(float64(v) / 1e3)
+}
+
+func decimalCores(vf float64) string {
 	if vf < 0 {
 		vf = 0
 	}
+	// Branch on rounded values so ie .999 or 9.99 don't render as 1.00 or 10.0.
 	ret := ""
-	if vf < 0.01 {
+	switch {
+	case vf < 0.01:
 		ret = "0"
-	} else if vf < 1 {
+	case math.Round(vf*100) < 100:
 		ret = "." + strings.TrimPrefix(fmt.Sprintf("%.2f", vf), "0.")
 		if len(ret) == 3 && ret[1] != '0' {
 			ret = strings.TrimSuffix(ret, "0")
 		}
-	} else if vf < 10 {
+	case math.Round(vf*10) < 100:
 		ret = fmt.Sprintf("%.1f", vf)
 		ret = strings.TrimSuffix(ret, ".0")
-	} else {
+	default:
 		ret = fmt.Sprintf("%.0f", vf)
 	}
 	return ret
 }
 
+// signedDecimal renders a millicores delta with its sign ie +1.5 or -2.
+func signedDecimal(delta int64) string {
+	switch {
+	case delta > 0:
+		return "+" + decimal(delta)
+	case delta < 0:
+		return "-" + decimalCores(-float64(delta)/1e3)
+	default:
+		return ZeroValue
+	}
+}
+
+// signedBytes renders a bytes delta with its sign ie +512M or -1G.
+func signedBytes(delta int64) string {
+	if delta > 0 {
+		return "+" + humanizeBytes(delta)
+	}
+
+	return humanizeBytes(delta)
+}
+
+// Trend glyphs. Override ie ^/v/= for ascii only terminals.
+var (
+	TrendUpGlyph   = "↑"
+	TrendDownGlyph = "↓"
+	TrendFlatGlyph = "→"
+)
+
+// NoSample tracks a missing previous usage sample.
+const NoSample int64 = -1
+
+// TrendArrow returns the direction of change between two usage samples.
+// A missing previous sample ie NoSample renders blank.
+func TrendArrow(prev, cur int64) string {
+	switch {
+	case prev < 0:
+		return ""
+	case cur > prev:
+		return TrendUpGlyph
+	case cur < prev:
+		return TrendDownGlyph
+	default:
+		return TrendFlatGlyph
+	}
+}
+
+// TrendArrowDelta returns the direction of change between two usage samples
+// followed by its magnitude ie ↑512M for memory or ↓.5 for cpu.
+func TrendArrowDelta(prev, cur int64, kind ResourceKind) string {
+	a := TrendArrow(prev, cur)
+	if a == "" || cur == prev {
+		return a
+	}
+	d := cur - prev
+	if d < 0 {
+		d = -d
+	}
+	if kind == ResourceMemory {
+		return a + humanizeBytes(d)
+	}
+
+	return a + decimal(d)
+}
+
 func decimalPct(v, l int64) string {
 	if l <= 0 {
 		return decimal(v)
@@ -369,6 +1679,142 @@ func decimalPct(v, l int64) string {
 	return vStr + "/" + lStr + pctStr
 }
 
+// decimalPctCollapsed renders like decimalPct but drops the limit when fully
+// used ie 5(100%) rather than 5/5(100%).
+func decimalPctCollapsed(v, l int64) string {
+	if l <= 0 || v != l {
+		return decimalPct(v, l)
+	}
+
+	return decimal(v) + "(100%)"
+}
+
+var cpuUnit atomic.Value
+
+// SetCPUUnit sets the user cpu unit preference. Blank uses view defaults.
+func SetCPUUnit(u string) {
+	cpuUnit.Store(u)
+}
+
+// CPUUnit returns the user cpu unit preference if any.
+func CPUUnit() string {
+	u, _ := cpuUnit.Load().(string)
+
+	return u
+}
+
+// FormatCPU renders millicores per the cpu unit preference or the given default unit.
+func FormatCPU(v int64, unit string) string {
+	if u := CPUUnit(); u != "" {
+		unit = u
+	}
+	if unit == client.CPUMillicores {
+		if v < 0 {
+			v = 0
+		}
+		return AsThousands(v) + "m"
+	}
+
+	return decimal(v)
+}
+
+// ResourceKind tracks a compute resource kind.
+type ResourceKind int
+
+const (
+	// ResourceCPU represents a cpu quantity.
+	ResourceCPU ResourceKind = iota
+
+	// ResourceMemory represents a memory quantity.
+	ResourceMemory
+)
+
+// RenderQuantity renders a cpu or memory quantity without lossy int64 round trips.
+func RenderQuantity(q resource.Quantity, kind ResourceKind) string {
+	if kind == ResourceMemory {
+		return humanizeBytes(q.Value())
+	}
+
+	cores := q.AsApproximateFloat64()
+	if cores < 0 {
+		cores = 0
+	}
+	if CPUUnit() != client.CPUMillicores {
+		return decimalCores(cores)
+	}
+	m := cores * 1e3
+	if m == math.Trunc(m) {
+		return AsThousands(int64(m)) + "m"
+	}
+
+	return strconv.FormatFloat(math.Round(m*1e3)/1e3, 'f', -1, 64) + "m"
+}
+
+// RenderUsage renders cpu millicores or memory bytes usage against its limit ie 250m/500m(50%).
+// Requests stand in for unset limits and usage renders alone when neither is set.
+func RenderUsage(used, req, lim int64, kind ResourceKind) string {
+	if lim <= 0 {
+		lim = req
+	}
+	if kind == ResourceMemory {
+		return memPct(used, lim)
+	}
+
+	return cpuPct(used, lim)
+}
+
+// RenderExtended renders an extended resource quantity ie nvidia.com/gpu as a plain count.
+// Huge pages and storage render as bytes. Fractions are kept, not rounded, so
+// invalid quantities on integer only resources remain noticeable ie 0.5.
+func RenderExtended(name string, q resource.Quantity) string {
+	if q.IsZero() {
+		return ZeroValue
+	}
+	if strings.HasPrefix(name, v1.ResourceHugePagesPrefix) ||
+		name == string(v1.ResourceEphemeralStorage) ||
+		name == string(v1.ResourceStorage) {
+		return humanizeBytes(q.Value())
+	}
+	if n, ok := q.AsInt64(); ok {
+		return strconv.FormatInt(n, 10)
+	}
+	s := strconv.FormatFloat(q.AsApproximateFloat64(), 'f', 3, 64)
+
+	return strings.TrimRight(strings.TrimRight(s, "0"), ".")
+}
+
+// FormatCapacity renders a node allocatable against its capacity ie 14/16(88%) for cpu
+// millicores or 60G/64G(94%) for memory bytes, leaving the reserved overhead implied.
+func FormatCapacity(alloc, capacity int64, kind ResourceKind) string {
+	if capacity <= 0 {
+		return NAValue
+	}
+
+	return RenderUsage(alloc, 0, capacity, kind)
+}
+
+func cpuPct(v, l int64) string {
+	u := CPUUnit()
+	if u == "" {
+		return decimalPct(v, l)
+	}
+	if l <= 0 {
+		return FormatCPU(v, u)
+	}
+	pct := float64(v) / float64(l) * 100
+
+	return FormatCPU(v, u) + "/" + FormatCPU(l, u) + fmt.Sprintf("(%.0f%%)", pct)
+}
+
+// cpuPctCollapsed renders like cpuPct but drops the limit when fully used.
+func cpuPctCollapsed(v, l int64) string {
+	if CPUUnit() == "" {
+		return decimalPctCollapsed(v, l)
+	}
+
+	return cpuPct(v, l)
+}
+
 func boolPtrToStr(b *bool) string {
 	if b == nil {
 		return "false"
@@ -377,6 +1823,15 @@ func boolPtrToStr(b *bool) string {
 	return boolToStr(*b)
 }
 
+// boolPtrToStr3 renders tri-state booleans, nil renders as unset.
+func boolPtrToStr3(b *bool) string {
+	if b == nil {
+		return UnsetValue
+	}
+
+	return boolToStr(*b)
+}
+
 func strPtrToStr(s *string) string {
 	if s == nil {
 		return ""
@@ -384,15 +1839,45 @@ func strPtrToStr(s *string) string {
 	return *s
 }
 
-// Pad a string up to the given length or truncates if greater than length.
-func Pad(s string, width int) string {
-	if len(s) == width {
-		return s
+// strPtrOrUnset renders nil as unset so it reads apart from an empty string.
+func strPtrOrUnset(s *string) string {
+	if s == nil {
+		return UnsetValue
 	}
+	return *s
+}
+
+// Pad a string up to the given display width or truncates if wider.
+// Widths are measured in terminal cells so wide runes are accounted for.
+func Pad(s string, width int) string {
+	s, gap := fit(s, width)
+
+	return s + strings.Repeat(" ", gap)
+}
+
+// PadRight right aligns a string up to the given display width or truncates if wider.
+func PadRight(s string, width int) string {
+	s, gap := fit(s, width)
+
+	return strings.Repeat(" ", gap) + s
+}
+
+// Center centers a string within the given display width or truncates if wider.
+// Odd gaps leave the extra space on the right.
+func Center(s string, width int) string {
+	s, gap := fit(s, width)
+	l := gap / 2
+
+	return strings.Repeat(" ", l) + s + strings.Repeat(" ", gap-l)
+}
 
-	if len(s) > width {
-		return Truncate(s, width)
+// fit truncates a string wider than width and returns the cells left to fill.
+func fit(s string, width int) (string, int) {
+	w := runewidth.StringWidth(s)
+	if w > width {
+		s = Truncate(s, width)
+		w = runewidth.StringWidth(s)
 	}
 
-	return s + strings.Repeat(" ", width-len(s))
+	return s, max(width-w, 0)
 }
@@ -6,14 +6,24 @@ package render
 import (
 	"encoding/json"
 	"fmt"
+	"math"
 	"os"
+	"sort"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/vul"
+	"github.com/derailed/tcell/v2"
+	"github.com/mattn/go-runewidth"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
@@ -78,355 +88,3600 @@ func TestToAge(t *testing.T) {
 	}
 }
 
-func TestToAgeHuman(t *testing.T) {
+func TestToAgeAbsolute(t *testing.T) {
+	ts := time.Date(2024, 1, 2, 15, 4, 5, 0, time.Local)
 	uu := map[string]struct {
-		t, e string
+		t      time.Time
+		format string
+		e      string
 	}{
-		"blank": {
-			t: "",
+		"zero": {
 			e: UnknownValue,
 		},
-		"good": {
-			t: time.Now().Add(-10 * time.Second).Format(time.RFC3339Nano),
-			e: "10s",
+		"default": {
+			t:      ts,
+			format: DefaultAgeFormat,
+			e:      "2024-01-02 15:04",
+		},
+		"blank-format": {
+			t: ts,
+			e: "2024-01-02 15:04",
+		},
+		"12h": {
+			t:      ts,
+			format: "Jan 2 3:04PM",
+			e:      "Jan 2 3:04PM",
 		},
 	}
 
+	defer func(f string, b bool) { AgeFormat, AbsoluteAge = f, b }(AgeFormat, AbsoluteAge)
 	for k := range uu {
 		u := uu[k]
 		t.Run(k, func(t *testing.T) {
-			assert.Equal(t, u.e, toAgeHuman(u.t))
+			AgeFormat, AbsoluteAge = u.format, true
+			assert.Equal(t, u.e, ToAgeAbsolute(metav1.Time{Time: u.t}))
+			assert.Equal(t, u.e, ToAge(metav1.Time{Time: u.t}))
 		})
 	}
 }
 
-func TestJoin(t *testing.T) {
+func TestToDuration(t *testing.T) {
+	start := metav1.NewTime(time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC))
 	uu := map[string]struct {
-		i []string
-		e string
+		start, end metav1.Time
+		e          string
 	}{
-		"zero":      {[]string{}, ""},
-		"std":       {[]string{"a", "b", "c"}, "a,b,c"},
-		"blank":     {[]string{"", "", ""}, ""},
-		"sparse":    {[]string{"a", "", "c"}, "a,c"},
-		"withBlank": {[]string{"", "a", "c"}, "a,c"},
+		"no-start": {
+			end: start,
+			e:   UnknownValue,
+		},
+		"no-end": {
+			start: start,
+			e:     UnknownValue,
+		},
+		"backwards": {
+			start: start,
+			end:   metav1.NewTime(start.Add(-time.Minute)),
+			e:     NAValue,
+		},
+		"same": {
+			start: start,
+			end:   start,
+			e:     "0s",
+		},
+		"elapsed": {
+			start: start,
+			end:   metav1.NewTime(start.Add(90 * time.Second)),
+			e:     "90s",
+		},
+		"hours": {
+			start: start,
+			end:   metav1.NewTime(start.Add(3*time.Hour + 20*time.Minute)),
+			e:     "3h20m",
+		},
 	}
 
 	for k := range uu {
-		uc := uu[k]
+		u := uu[k]
 		t.Run(k, func(t *testing.T) {
-			assert.Equal(t, uc.e, join(uc.i, ","))
+			assert.Equal(t, u.e, ToDuration(u.start, u.end))
 		})
 	}
 }
 
-func TestBoolPtrToStr(t *testing.T) {
-	tv, fv := true, false
-
-	uu := []struct {
-		p *bool
-		e string
+func TestToAgeCompact(t *testing.T) {
+	uu := map[string]struct {
+		d    time.Duration
+		zero bool
+		e    string
 	}{
-		{nil, "false"},
-		{&tv, "true"},
-		{&fv, "false"},
+		"zero": {
+			zero: true,
+			e:    UnknownValue,
+		},
+		"secs": {
+			d: 10 * time.Second,
+			e: "10s",
+		},
+		"mins": {
+			d: 45*time.Minute + 30*time.Second,
+			e: "45m",
+		},
+		"hours": {
+			d: 2*time.Hour + 59*time.Minute,
+			e: "2h",
+		},
+		"days": {
+			d: 3*24*time.Hour + 2*time.Hour,
+			e: "3d",
+		},
+		"years": {
+			d: 800 * 24 * time.Hour,
+			e: "2y",
+		},
 	}
 
-	for _, u := range uu {
-		assert.Equal(t, u.e, boolPtrToStr(u.p))
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var ts metav1.Time
+			if !u.zero {
+				ts = metav1.NewTime(time.Now().Add(-u.d))
+			}
+			assert.Equal(t, u.e, ToAgeCompact(ts))
+		})
 	}
 }
 
-func TestNamespaced(t *testing.T) {
-	uu := []struct {
-		p, ns, n string
+func TestIsStale(t *testing.T) {
+	uu := map[string]struct {
+		d         time.Duration
+		zero      bool
+		threshold time.Duration
+		e         bool
 	}{
-		{"fred/blee", "fred", "blee"},
+		"zero": {
+			zero:      true,
+			threshold: time.Minute,
+		},
+		"fresh": {
+			d:         10 * time.Second,
+			threshold: time.Minute,
+		},
+		"stale": {
+			d:         2 * time.Minute,
+			threshold: time.Minute,
+			e:         true,
+		},
+		"disabled": {
+			d: time.Hour,
+		},
 	}
 
-	for _, u := range uu {
-		ns, n := client.Namespaced(u.p)
-		assert.Equal(t, u.ns, ns)
-		assert.Equal(t, u.n, n)
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var ts metav1.Time
+			if !u.zero {
+				ts = metav1.NewTime(time.Now().Add(-u.d))
+			}
+			assert.Equal(t, u.e, IsStale(ts, u.threshold))
+		})
 	}
 }
 
-func TestMissing(t *testing.T) {
-	uu := []struct {
-		i, e string
-	}{
-		{"fred", "fred"},
-		{"", MissingValue},
-	}
+func TestToAgeStale(t *testing.T) {
+	defer func(d time.Duration) { StaleThreshold = d }(StaleThreshold)
+	StaleThreshold = time.Minute
 
-	for _, u := range uu {
-		assert.Equal(t, u.e, missing(u.i))
-	}
+	ts := metav1.NewTime(time.Now().Add(-3 * time.Hour))
+	assert.Equal(t, "3h", ToAgeStale(ts, metav1.Now()))
+	assert.Equal(t, "3h*", ToAgeStale(ts, metav1.NewTime(time.Now().Add(-5*time.Minute))))
+	assert.Equal(t, UnknownValue, ToAgeStale(metav1.Time{}, metav1.Now()))
 }
 
-func TestBoolToStr(t *testing.T) {
-	uu := []struct {
-		i bool
-		e string
+func TestToExpiry(t *testing.T) {
+	uu := map[string]struct {
+		d    time.Duration
+		zero bool
+		e    string
+		b    Bucket
 	}{
-		{true, "true"},
-		{false, "false"},
+		"zero": {
+			zero: true,
+			e:    UnknownValue,
+			b:    BucketUnknown,
+		},
+		"future": {
+			d: 30*24*time.Hour + time.Minute,
+			e: "in 30d",
+			b: BucketLow,
+		},
+		"soon": {
+			d: 3*24*time.Hour + time.Minute,
+			e: "in 3d",
+			b: BucketHigh,
+		},
+		"expired": {
+			d: -5 * time.Hour,
+			e: "expired 5h ago",
+			b: BucketCritical,
+		},
 	}
 
-	for _, u := range uu {
-		assert.Equal(t, u.e, boolToStr(u.i))
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var ts metav1.Time
+			if !u.zero {
+				ts = metav1.NewTime(time.Now().Add(u.d))
+			}
+			assert.Equal(t, u.e, ToExpiry(ts))
+			assert.Equal(t, u.b, ExpiryBucket(ts))
+		})
 	}
 }
 
-func TestNa(t *testing.T) {
-	uu := []struct {
-		i, e string
+func TestSecondsToHuman(t *testing.T) {
+	uu := map[string]struct {
+		sec int64
+		e   string
 	}{
-		{"fred", "fred"},
-		{"", NAValue},
+		"negative": {sec: -1, e: NAValue},
+		"zero":     {e: ZeroValue},
+		"secs":     {sec: 45, e: "45s"},
+		"minute":   {sec: 60, e: "1m"},
+		"mins":     {sec: 90, e: "1m30s"},
+		"hour":     {sec: 7_200, e: "2h"},
+		"hours":    {sec: 3_600 + 25*60 + 10, e: "1h25m"},
+		"days":     {sec: 3*86_400 + 4*3_600 + 59, e: "3d4h"},
+		"day":      {sec: 86_400 + 30, e: "1d"},
 	}
 
-	for _, u := range uu {
-		assert.Equal(t, u.e, na(u.i))
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, SecondsToHuman(u.sec))
+		})
 	}
 }
 
-func TestTruncate(t *testing.T) {
+func TestRestartInfo(t *testing.T) {
 	uu := map[string]struct {
-		data string
-		size int
-		e    string
+		count int32
+		d     time.Duration
+		zero  bool
+		e     string
 	}{
-		"same": {
-			data: "fred",
-			size: 4,
-			e:    "fred",
+		"none": {
+			d: time.Hour,
+			e: "0",
 		},
-		"small": {
-			data: "fred",
-			size: 10,
-			e:    "fred",
+		"recent": {
+			count: 5,
+			d:     2 * time.Minute,
+			e:     "5 (2m ago)",
 		},
-		"larger": {
-			data: "fred",
-			size: 3,
-			e:    "fr…",
+		"old": {
+			count: 1,
+			d:     3 * 24 * time.Hour,
+			e:     "1 (3d ago)",
+		},
+		"unknown": {
+			count: 3,
+			zero:  true,
+			e:     "3",
 		},
 	}
 
 	for k := range uu {
 		u := uu[k]
 		t.Run(k, func(t *testing.T) {
-			assert.Equal(t, u.e, Truncate(u.data, u.size))
+			var ts metav1.Time
+			if !u.zero {
+				ts = metav1.NewTime(time.Now().Add(-u.d))
+			}
+			assert.Equal(t, u.e, RestartInfo(u.count, ts))
 		})
 	}
 }
 
-func TestToSelector(t *testing.T) {
+func TestAgeBucket(t *testing.T) {
 	uu := map[string]struct {
-		m map[string]string
-		e []string
+		d    time.Duration
+		zero bool
+		e    Bucket
 	}{
-		"cool": {
-			map[string]string{"app": "fred", "env": "test"},
-			[]string{"app=fred,env=test", "env=test,app=fred"},
+		"zero": {
+			zero: true,
+			e:    BucketUnknown,
 		},
-		"empty": {
-			map[string]string{},
-			[]string{""},
+		"new": {
+			d: time.Minute,
+			e: BucketHigh,
+		},
+		"normal": {
+			d: 24 * time.Hour,
+			e: BucketLow,
+		},
+		"old": {
+			d: 100 * 24 * time.Hour,
+			e: BucketMed,
 		},
 	}
 
 	for k := range uu {
-		uc := uu[k]
+		u := uu[k]
 		t.Run(k, func(t *testing.T) {
-			s := toSelector(uc.m)
-			var match bool
-			for _, e := range uc.e {
-				if e == s {
-					match = true
-				}
+			var ts metav1.Time
+			if !u.zero {
+				ts = metav1.NewTime(time.Now().Add(-u.d))
 			}
-			assert.True(t, match)
+			assert.Equal(t, u.e, AgeBucket(ts))
 		})
 	}
 }
 
-func TestBlank(t *testing.T) {
+func TestToAgeFixed(t *testing.T) {
 	uu := map[string]struct {
-		a []string
-		e bool
+		d     time.Duration
+		zero  bool
+		width int
+		e     string
 	}{
-		"full": {
-			a: []string{"fred", "blee"},
+		"zero": {
+			zero:  true,
+			width: 12,
+			e:     "   <unknown>",
 		},
-		"empty": {
-			e: true,
+		"days": {
+			d:     3 * 24 * time.Hour,
+			width: 4,
+			e:     "  3d",
 		},
-		"blank": {
-			a: []string{"fred", ""},
+		"exact": {
+			d:     45 * time.Minute,
+			width: 3,
+			e:     "45m",
+		},
+		"truncate": {
+			d:     800 * 24 * time.Hour,
+			width: 1,
+			e:     "…",
 		},
 	}
 
 	for k := range uu {
-		uc := uu[k]
+		u := uu[k]
 		t.Run(k, func(t *testing.T) {
-			assert.Equal(t, uc.e, blank(uc.a))
+			var ts metav1.Time
+			if !u.zero {
+				ts = metav1.NewTime(time.Now().Add(-u.d))
+			}
+			assert.Equal(t, u.e, ToAgeFixed(ts, u.width))
 		})
 	}
 }
 
-func TestMetaFQN(t *testing.T) {
+func TestToAgeHuman(t *testing.T) {
 	uu := map[string]struct {
-		m metav1.ObjectMeta
-		e string
+		t, e string
 	}{
-		"full": {metav1.ObjectMeta{Namespace: "fred", Name: "blee"}, "fred/blee"},
-		"nons": {metav1.ObjectMeta{Name: "blee"}, "-/blee"},
+		"blank": {
+			t: "",
+			e: UnknownValue,
+		},
+		"good": {
+			t: time.Now().Add(-10 * time.Second).Format(time.RFC3339Nano),
+			e: "10s",
+		},
+		"rfc3339": {
+			t: time.Now().Add(-10 * time.Second).UTC().Format(time.RFC3339),
+			e: "10s",
+		},
+		"micro": {
+			t: time.Now().Add(-10 * time.Second).Format(metav1.RFC3339Micro),
+			e: "10s",
+		},
+		"no-zone": {
+			t: time.Now().Add(-10 * time.Second).Format("2006-01-02T15:04:05"),
+			e: "10s",
+		},
+		"toast": {
+			t: "fred",
+			e: NAValue,
+		},
 	}
 
 	for k := range uu {
-		uc := uu[k]
+		u := uu[k]
 		t.Run(k, func(t *testing.T) {
-			assert.Equal(t, uc.e, client.MetaFQN(&uc.m))
+			assert.Equal(t, u.e, toAgeHuman(u.t))
 		})
 	}
 }
 
-func TestFQN(t *testing.T) {
+func TestJoin(t *testing.T) {
 	uu := map[string]struct {
-		ns, n string
-		e     string
+		i []string
+		e string
 	}{
-		"full": {ns: "fred", n: "blee", e: "fred/blee"},
-		"nons": {n: "blee", e: "blee"},
+		"zero":      {[]string{}, ""},
+		"std":       {[]string{"a", "b", "c"}, "a,b,c"},
+		"blank":     {[]string{"", "", ""}, ""},
+		"sparse":    {[]string{"a", "", "c"}, "a,c"},
+		"withBlank": {[]string{"", "a", "c"}, "a,c"},
 	}
 
 	for k := range uu {
 		uc := uu[k]
 		t.Run(k, func(t *testing.T) {
-			assert.Equal(t, uc.e, client.FQN(uc.ns, uc.n))
+			assert.Equal(t, uc.e, join(uc.i, ","))
 		})
 	}
 }
 
-func TestMapToStr(t *testing.T) {
-	uu := []struct {
-		i map[string]string
-		e string
+func TestJoinBounded(t *testing.T) {
+	uu := map[string]struct {
+		ss    []string
+		width int
+		e     string
 	}{
-		{map[string]string{"blee": "duh", "aa": "bb"}, "aa=bb,blee=duh"},
-		{map[string]string{}, ""},
-	}
-	for _, u := range uu {
-		assert.Equal(t, u.e, mapToStr(u.i))
-	}
-}
-
-func BenchmarkMapToStr(b *testing.B) {
-	ll := map[string]string{
-		"blee": "duh",
-		"aa":   "bb",
+		"empty": {
+			width: 10,
+		},
+		"fits": {
+			ss:    []string{"a", "", "b", "c"},
+			width: 5,
+			e:     "a,b,c",
+		},
+		"ascii": {
+			ss:    []string{"app", "web", "db", "cache"},
+			width: 12,
+			e:     "app,web,db,…",
+		},
+		"cjk": {
+			ss:    []string{"日本", "中文", "한국", "abc"},
+			width: 12,
+			e:     "日本,中文,…",
+		},
+		"too-narrow": {
+			ss:    []string{"fred", "blee"},
+			width: 3,
+			e:     "fr…",
+		},
+		"long-first": {
+			ss:    []string{"", "app.kubernetes.io/name=nginx", "b"},
+			width: 12,
+			e:     "app.kuberne…",
+		},
 	}
 
-	b.ReportAllocs()
-	b.ResetTimer()
-	for range b.N {
-		mapToStr(ll)
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			s := joinBounded(u.ss, ",", u.width)
+			assert.Equal(t, u.e, s)
+			assert.LessOrEqual(t, runewidth.StringWidth(s), u.width)
+		})
 	}
 }
 
-func TestRunesToNum(t *testing.T) {
+func TestBoundedLabels(t *testing.T) {
 	uu := map[string]struct {
-		rr []rune
-		e  int64
+		m     map[string]string
+		width int
+		e     string
 	}{
-		"0": {
-			rr: []rune(""),
-			e:  0,
+		"empty": {
+			width: 10,
 		},
-		"100": {
-			rr: []rune("100"),
-			e:  100,
+		"fits": {
+			m:     map[string]string{"b": "2", "a": "1"},
+			width: 10,
+			e:     "a=1,b=2",
 		},
-		"64": {
-			rr: []rune("64"),
-			e:  64,
+		"bounded": {
+			m:     map[string]string{"app": "web", "tier": "fe", "env": "prod"},
+			width: 16,
+			e:     "app=web,…",
 		},
-		"52640": {
-			rr: []rune("52640"),
-			e:  52640,
+		"long-first": {
+			m:     map[string]string{"app.kubernetes.io/name": "nginx", "tier": "fe"},
+			width: 16,
+			e:     "app.kubernetes.…",
 		},
 	}
 
 	for k := range uu {
 		u := uu[k]
 		t.Run(k, func(t *testing.T) {
-			assert.Equal(t, u.e, runesToNum(u.rr))
+			assert.Equal(t, u.e, boundedLabels(u.m, u.width))
 		})
 	}
 }
 
-func BenchmarkRunesToNum(b *testing.B) {
-	rr := []rune("5465")
+func TestJoinKeep(t *testing.T) {
+	uu := map[string]struct {
+		i     []string
+		e, eJ string
+	}{
+		"zero":   {i: []string{}},
+		"std":    {i: []string{"a", "b", "c"}, e: "a/b/c", eJ: "a/b/c"},
+		"blank":  {i: []string{"", ""}, e: "/"},
+		"sparse": {i: []string{"a", "", "c"}, e: "a//c", eJ: "a/c"},
+		"lead":   {i: []string{"", "b"}, e: "/b", eJ: "b"},
+	}
 
-	b.ReportAllocs()
-	b.ResetTimer()
-	for range b.N {
-		runesToNum(rr)
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, joinKeep(u.i, "/"))
+			assert.Equal(t, u.eJ, join(u.i, "/"))
+		})
 	}
 }
 
-func TestToMc(t *testing.T) {
+func TestBoolPtrToStr(t *testing.T) {
+	tv, fv := true, false
+
 	uu := []struct {
-		v int64
+		p *bool
 		e string
 	}{
-		{0, "0"},
-		{2, "2"},
-		{1_000, "1000"},
+		{nil, "false"},
+		{&tv, "true"},
+		{&fv, "false"},
 	}
 
 	for _, u := range uu {
-		assert.Equal(t, u.e, toMc(u.v))
+		assert.Equal(t, u.e, boolPtrToStr(u.p))
 	}
 }
 
-func TestToMi(t *testing.T) {
-	uu := []struct {
-		v int64
+func TestBoolPtrToStr3(t *testing.T) {
+	tv, fv := true, false
+
+	uu := map[string]struct {
+		p *bool
 		e string
 	}{
-		{0, "0"},
-		{2 * client.MegaByte, "2"},
-		{1_000 * client.MegaByte, "1000"},
+		"unset": {
+			e: UnsetValue,
+		},
+		"true": {
+			p: &tv,
+			e: "true",
+		},
+		"false": {
+			p: &fv,
+			e: "false",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, boolPtrToStr3(u.p))
+		})
+	}
+}
+
+func TestStrPtrOrUnset(t *testing.T) {
+	s, blank := "fred", ""
+
+	uu := map[string]struct {
+		p *string
+		e string
+	}{
+		"nil": {
+			e: UnsetValue,
+		},
+		"blank": {
+			p: &blank,
+		},
+		"value": {
+			p: &s,
+			e: "fred",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, strPtrOrUnset(u.p))
+			if u.p == nil {
+				assert.Empty(t, strPtrToStr(u.p))
+			}
+		})
+	}
+}
+
+func TestNamespaced(t *testing.T) {
+	uu := []struct {
+		p, ns, n string
+	}{
+		{"fred/blee", "fred", "blee"},
 	}
 
 	for _, u := range uu {
-		assert.Equal(t, u.e, toMi(u.v))
+		ns, n := client.Namespaced(u.p)
+		assert.Equal(t, u.ns, ns)
+		assert.Equal(t, u.n, n)
 	}
 }
 
-func TestIntToStr(t *testing.T) {
+func TestMissing(t *testing.T) {
 	uu := []struct {
-		v int
+		i, e string
+	}{
+		{"fred", "fred"},
+		{"", MissingValue},
+	}
+
+	for _, u := range uu {
+		assert.Equal(t, u.e, missing(u.i))
+	}
+}
+
+func TestPlaceholders(t *testing.T) {
+	uu := map[string]struct {
+		missing, na string
+		s           string
+		eM, eN, eNS string
+	}{
+		"defaults": {
+			missing: MissingValue,
+			na:      NAValue,
+			eM:      MissingValue,
+			eN:      NAValue,
+			eNS:     NAValue,
+		},
+		"custom": {
+			missing: "-",
+			na:      "n/a",
+			eM:      "-",
+			eN:      "n/a",
+			eNS:     "n/a",
+		},
+		"value": {
+			missing: "-",
+			na:      "n/a",
+			s:       "fred",
+			eM:      "fred",
+			eN:      "fred",
+			eNS:     "n/a",
+		},
+	}
+
+	defer func(m, n string) { MissingPlaceholder, NAPlaceholder = m, n }(MissingPlaceholder, NAPlaceholder)
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			MissingPlaceholder, NAPlaceholder = u.missing, u.na
+			assert.Equal(t, u.eM, missing(u.s))
+			assert.Equal(t, u.eN, na(u.s))
+			assert.Equal(t, u.eNS, naStrings(nil))
+		})
+	}
+}
+
+func TestBoolToStr(t *testing.T) {
+	uu := []struct {
+		i bool
 		e string
 	}{
-		{0, "0"},
-		{10, "10"},
+		{true, "true"},
+		{false, "false"},
 	}
 
 	for _, u := range uu {
-		assert.Equal(t, u.e, IntToStr(u.v))
+		assert.Equal(t, u.e, boolToStr(u.i))
 	}
 }
 
-func BenchmarkIntToStr(b *testing.B) {
-	v := 10
-	b.ResetTimer()
-	b.ReportAllocs()
-	for range b.N {
-		IntToStr(v)
+func TestNormalizeBool(t *testing.T) {
+	uu := map[string]struct {
+		s, e, eG string
+	}{
+		"True":    {s: "True", e: "true", eG: TrueGlyph},
+		"true":    {s: "true", e: "true", eG: TrueGlyph},
+		"TRUE":    {s: "TRUE", e: "true", eG: TrueGlyph},
+		"1":       {s: "1", e: "true", eG: TrueGlyph},
+		"yes":     {s: "yes", e: "true", eG: TrueGlyph},
+		"Y":       {s: "Y", e: "true", eG: TrueGlyph},
+		"on":      {s: " on ", e: "true", eG: TrueGlyph},
+		"False":   {s: "False", e: "false", eG: FalseGlyph},
+		"0":       {s: "0", e: "false", eG: FalseGlyph},
+		"no":      {s: "no", e: "false", eG: FalseGlyph},
+		"off":     {s: "Off", e: "false", eG: FalseGlyph},
+		"unknown": {s: "Unknown", e: "Unknown", eG: "Unknown"},
+		"blank":   {},
+		"number":  {s: "2", e: "2", eG: "2"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, NormalizeBool(u.s))
+			assert.Equal(t, u.eG, NormalizeBoolGlyph(u.s))
+		})
+	}
+}
+
+func TestBoolToGlyph(t *testing.T) {
+	uu := map[string]struct {
+		b      bool
+		glyphs []string
+		e      string
+	}{
+		"true": {
+			b: true,
+			e: "✓",
+		},
+		"false": {
+			e: "✗",
+		},
+		"ascii-true": {
+			b:      true,
+			glyphs: []string{"Y", "N"},
+			e:      "Y",
+		},
+		"ascii-false": {
+			glyphs: []string{"Y", "N"},
+			e:      "N",
+		},
+	}
+
+	defer func(t, f string) { TrueGlyph, FalseGlyph = t, f }(TrueGlyph, FalseGlyph)
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			TrueGlyph, FalseGlyph = "✓", "✗"
+			if len(u.glyphs) == 2 {
+				TrueGlyph, FalseGlyph = u.glyphs[0], u.glyphs[1]
+			}
+			assert.Equal(t, u.e, boolToGlyph(u.b))
+		})
+	}
+}
+
+func TestNa(t *testing.T) {
+	uu := []struct {
+		i, e string
+	}{
+		{"fred", "fred"},
+		{"", NAValue},
+	}
+
+	for _, u := range uu {
+		assert.Equal(t, u.e, na(u.i))
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	uu := map[string]struct {
+		data string
+		size int
+		e    string
+	}{
+		"same": {
+			data: "fred",
+			size: 4,
+			e:    "fred",
+		},
+		"small": {
+			data: "fred",
+			size: 10,
+			e:    "fred",
+		},
+		"larger": {
+			data: "fred",
+			size: 3,
+			e:    "fr…",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, Truncate(u.data, u.size))
+		})
+	}
+}
+
+func TestTruncateN(t *testing.T) {
+	uu := map[string]struct {
+		data  string
+		width int
+		e     string
+		n     int
+	}{
+		"fits": {
+			data:  "fred",
+			width: 4,
+			e:     "fred",
+		},
+		"truncated": {
+			data:  "nginx-7d9c8b5f4-abcde",
+			width: 10,
+			e:     "nginx-7d9…",
+			n:     12,
+		},
+		"wide": {
+			data:  "日本語テキスト",
+			width: 7,
+			e:     "日本語…",
+			n:     8,
+		},
+		"ellipsis-only": {
+			data:  "fred",
+			width: 1,
+			e:     "…",
+			n:     4,
+		},
+		"zero": {
+			data: "fred",
+			n:    4,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			s, n := TruncateN(u.data, u.width)
+			assert.Equal(t, u.e, s)
+			assert.Equal(t, u.n, n)
+		})
+	}
+}
+
+func TestTruncateLeftMiddle(t *testing.T) {
+	uu := map[string]struct {
+		data   string
+		size   int
+		eL, eM string
+	}{
+		"fits": {
+			data: "fred",
+			size: 4,
+			eL:   "fred",
+			eM:   "fred",
+		},
+		"zero": {
+			data: "fred",
+		},
+		"one": {
+			data: "fred",
+			size: 1,
+			eL:   "…",
+			eM:   "…",
+		},
+		"image": {
+			data: "registry.example.io/repo/app:v1.2.3",
+			size: 16,
+			eL:   "…repo/app:v1.2.3",
+			eM:   "registry…:v1.2.3",
+		},
+		"cjk": {
+			data: "日本語テキスト",
+			size: 6,
+			eL:   "…スト",
+			eM:   "日…ト",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			l, m := TruncateLeft(u.data, u.size), TruncateMiddle(u.data, u.size)
+			assert.Equal(t, u.eL, l)
+			assert.Equal(t, u.eM, m)
+			assert.LessOrEqual(t, runewidth.StringWidth(l), u.size)
+			assert.LessOrEqual(t, runewidth.StringWidth(m), u.size)
+		})
+	}
+}
+
+func TestShortenFQN(t *testing.T) {
+	const fqn = "foo.bar.baz.example.com"
+
+	uu := map[string]struct {
+		name  string
+		width int
+		e     string
+	}{
+		"fits": {
+			name:  fqn,
+			width: 30,
+			e:     fqn,
+		},
+		"exact": {
+			name:  fqn,
+			width: 23,
+			e:     fqn,
+		},
+		"one-elided": {
+			name:  fqn,
+			width: 21,
+			e:     "foo.….baz.example.com",
+		},
+		"middle": {
+			name:  fqn,
+			width: 17,
+			e:     "foo.….example.com",
+		},
+		"last": {
+			name:  fqn,
+			width: 12,
+			e:     "foo.….com",
+		},
+		"narrow": {
+			name:  fqn,
+			width: 9,
+			e:     "foo.….com",
+		},
+		"too-narrow": {
+			name:  fqn,
+			width: 7,
+			e:     "foo…com",
+		},
+		"no-dots": {
+			name:  "deployments",
+			width: 6,
+			e:     "dep…ts",
+		},
+		"two-segments": {
+			name:  "gizmos.acme",
+			width: 6,
+			e:     "giz…me",
+		},
+		"zero": {
+			name: fqn,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			s := ShortenFQN(u.name, u.width)
+			assert.Equal(t, u.e, s)
+			assert.LessOrEqual(t, runewidth.StringWidth(s), u.width)
+		})
+	}
+}
+
+func TestWrapWidth(t *testing.T) {
+	uu := map[string]struct {
+		s     string
+		width int
+		e     []string
+	}{
+		"empty": {
+			width: 10,
+			e:     []string{""},
+		},
+		"fits": {
+			s:     "hello world",
+			width: 20,
+			e:     []string{"hello world"},
+		},
+		"words": {
+			s:     "the quick brown fox jumps",
+			width: 10,
+			e:     []string{"the quick", "brown fox", "jumps"},
+		},
+		"exact": {
+			s:     "aaaa bbbb",
+			width: 4,
+			e:     []string{"aaaa", "bbbb"},
+		},
+		"hard-break": {
+			s:     "see abcdefghij now",
+			width: 4,
+			e:     []string{"see", "abcd", "efgh", "ij", "now"},
+		},
+		"spaces": {
+			s:     "a   b    c",
+			width: 3,
+			e:     []string{"a b", "c"},
+		},
+		"cjk": {
+			s:     "日本語のテキスト",
+			width: 5,
+			e:     []string{"日本", "語の", "テキ", "スト"},
+		},
+		"cjk-words": {
+			s:     "日本 テキスト",
+			width: 6,
+			e:     []string{"日本", "テキス", "ト"},
+		},
+		"no-width": {
+			s: "hello world",
+			e: []string{"hello world"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ll := WrapWidth(u.s, u.width)
+			assert.Equal(t, u.e, ll)
+			if u.width > 0 {
+				for _, l := range ll {
+					assert.LessOrEqual(t, runewidth.StringWidth(l), u.width)
+				}
+			}
+		})
+	}
+}
+
+func TestTruncateGraphemes(t *testing.T) {
+	const (
+		decomposed = "cafe\u0301s"
+		flags      = "\U0001F1EB\U0001F1F7\U0001F1E9\U0001F1EA\U0001F1EF\U0001F1F5"
+	)
+
+	uu := map[string]struct {
+		data       string
+		width      int
+		eR, eL, eM string
+	}{
+		"accent-2": {
+			data:  decomposed,
+			width: 2,
+			eR:    "c…",
+			eL:    "…s",
+			eM:    "c…",
+		},
+		"accent-3": {
+			data:  decomposed,
+			width: 3,
+			eR:    "ca…",
+			eL:    "…e\u0301s",
+			eM:    "c…s",
+		},
+		"accent-4": {
+			data:  decomposed,
+			width: 4,
+			eR:    "caf…",
+			eL:    "…fe\u0301s",
+			eM:    "ca…s",
+		},
+		"flags-2": {
+			data:  flags,
+			width: 2,
+			eR:    "\U0001F1EB\U0001F1F7…",
+			eL:    "…\U0001F1EF\U0001F1F5",
+			eM:    "\U0001F1EB\U0001F1F7…",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.eR, Truncate(u.data, u.width))
+			assert.Equal(t, u.eL, TruncateLeft(u.data, u.width))
+			assert.Equal(t, u.eM, TruncateMiddle(u.data, u.width))
+		})
+	}
+}
+
+func TestSetEllipsis(t *testing.T) {
+	uu := map[string]struct {
+		data       string
+		width      int
+		eR, eL, eM string
+	}{
+		"fits": {
+			data:  "fred",
+			width: 4,
+			eR:    "fred",
+			eL:    "fred",
+			eM:    "fred",
+		},
+		"truncated": {
+			data:  "registry/app:v1",
+			width: 9,
+			eR:    "regist...",
+			eL:    "...app:v1",
+			eM:    "reg...:v1",
+		},
+		"narrow": {
+			data:  "fred",
+			width: 2,
+			eR:    "..",
+			eL:    "..",
+			eM:    "..",
+		},
+	}
+
+	defer SetEllipsis(Ellipsis())
+	SetEllipsis("...")
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.eR, Truncate(u.data, u.width))
+			assert.Equal(t, u.eL, TruncateLeft(u.data, u.width))
+			assert.Equal(t, u.eM, TruncateMiddle(u.data, u.width))
+		})
+	}
+}
+
+func TestPad(t *testing.T) {
+	uu := map[string]struct {
+		data  string
+		width int
+		e     string
+	}{
+		"exact": {
+			data:  "fred",
+			width: 4,
+			e:     "fred",
+		},
+		"pad": {
+			data:  "fred",
+			width: 6,
+			e:     "fred  ",
+		},
+		"truncate": {
+			data:  "fred",
+			width: 3,
+			e:     "fr…",
+		},
+		"cjk-exact": {
+			data:  "日本語",
+			width: 6,
+			e:     "日本語",
+		},
+		"cjk-pad": {
+			data:  "日本語",
+			width: 8,
+			e:     "日本語  ",
+		},
+		"cjk-truncate": {
+			data:  "日本語",
+			width: 4,
+			e:     "日… ",
+		},
+		"emoji": {
+			data:  "🚀go",
+			width: 6,
+			e:     "🚀go  ",
+		},
+		"combining": {
+			data:  "cafe\u0301",
+			width: 6,
+			e:     "cafe\u0301  ",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			s := Pad(u.data, u.width)
+			assert.Equal(t, u.e, s)
+			assert.Equal(t, u.width, runewidth.StringWidth(s))
+		})
+	}
+}
+
+func TestPadRight(t *testing.T) {
+	uu := map[string]struct {
+		data  string
+		width int
+		e, eL string
+	}{
+		"number": {
+			data:  "42",
+			width: 6,
+			e:     "    42",
+			eL:    "42    ",
+		},
+		"exact": {
+			data:  "1,024",
+			width: 5,
+			e:     "1,024",
+			eL:    "1,024",
+		},
+		"truncate": {
+			data:  "123456",
+			width: 4,
+			e:     "123…",
+			eL:    "123…",
+		},
+		"cjk": {
+			data:  "日本",
+			width: 6,
+			e:     "  日本",
+			eL:    "日本  ",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			s := PadRight(u.data, u.width)
+			assert.Equal(t, u.e, s)
+			assert.Equal(t, u.width, runewidth.StringWidth(s))
+			assert.Equal(t, u.eL, Pad(u.data, u.width))
+		})
+	}
+}
+
+func TestCenter(t *testing.T) {
+	uu := map[string]struct {
+		data  string
+		width int
+		e     string
+	}{
+		"even": {
+			data:  "ab",
+			width: 6,
+			e:     "  ab  ",
+		},
+		"odd": {
+			data:  "ab",
+			width: 5,
+			e:     " ab  ",
+		},
+		"one": {
+			data:  "abc",
+			width: 4,
+			e:     "abc ",
+		},
+		"exact": {
+			data:  "abc",
+			width: 3,
+			e:     "abc",
+		},
+		"truncate": {
+			data:  "123456",
+			width: 4,
+			e:     "123…",
+		},
+		"cjk-even": {
+			data:  "日本",
+			width: 8,
+			e:     "  日本  ",
+		},
+		"cjk-odd": {
+			data:  "日本",
+			width: 7,
+			e:     " 日本  ",
+		},
+		"empty": {
+			width: 3,
+			e:     "   ",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			s := Center(u.data, u.width)
+			assert.Equal(t, u.e, s)
+			assert.Equal(t, u.width, runewidth.StringWidth(s))
+		})
+	}
+}
+
+func TestToSelector(t *testing.T) {
+	uu := map[string]struct {
+		m map[string]string
+		e string
+	}{
+		"cool": {
+			m: map[string]string{"app": "fred", "env": "test"},
+			e: "app=fred,env=test",
+		},
+		"sorted": {
+			m: map[string]string{"tier": "web", "app": "fred", "env": "test", "b": "1"},
+			e: "app=fred,b=1,env=test,tier=web",
+		},
+		"empty": {
+			m: map[string]string{},
+		},
+		"nil": {},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			for range 10 {
+				assert.Equal(t, u.e, toSelector(u.m))
+			}
+		})
+	}
+}
+
+func TestAsSelector(t *testing.T) {
+	uu := map[string]struct {
+		sel *metav1.LabelSelector
+		e   string
+	}{
+		"nil": {},
+		"empty": {
+			sel: &metav1.LabelSelector{},
+		},
+		"mixed": {
+			sel: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"tier": "web", "app": "fred"},
+				MatchExpressions: []metav1.LabelSelectorRequirement{
+					{Key: "env", Operator: metav1.LabelSelectorOpIn, Values: []string{"prod", "dev"}},
+					{Key: "canary", Operator: metav1.LabelSelectorOpDoesNotExist},
+					{Key: "zone", Operator: metav1.LabelSelectorOpExists},
+				},
+			},
+			e: "!canary,app=fred,env in (dev,prod),tier=web,zone",
+		},
+		"malformed": {
+			sel: &metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{
+					{Key: "env", Operator: "bozo"},
+				},
+			},
+			e: "invalid: \"bozo\" is not a valid label se…",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, asSelector(u.sel))
+		})
+	}
+}
+
+func TestFormatSelectorWithCount(t *testing.T) {
+	uu := map[string]struct {
+		sel     *metav1.LabelSelector
+		matched int
+		e       string
+	}{
+		"none": {
+			sel: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "nginx"}},
+			e:   "app=nginx (0 pods)",
+		},
+		"one": {
+			sel:     &metav1.LabelSelector{MatchLabels: map[string]string{"app": "nginx"}},
+			matched: 1,
+			e:       "app=nginx (1 pod)",
+		},
+		"many": {
+			sel:     &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "web", "app": "nginx"}},
+			matched: 3,
+			e:       "app=nginx,tier=web (3 pods)",
+		},
+		"nil": {
+			matched: 2,
+			e:       "(2 pods)",
+		},
+		"malformed": {
+			sel: &metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{
+					{Key: "env", Operator: "bozo"},
+				},
+			},
+			matched: 4,
+			e:       "invalid: \"bozo\" is not a valid label se… (4 pods)",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, FormatSelectorWithCount(u.sel, u.matched))
+		})
+	}
+}
+
+func TestBlank(t *testing.T) {
+	uu := map[string]struct {
+		a []string
+		e bool
+	}{
+		"full": {
+			a: []string{"fred", "blee"},
+		},
+		"empty": {
+			e: true,
+		},
+		"blank": {
+			a: []string{"fred", ""},
+		},
+	}
+
+	for k := range uu {
+		uc := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, uc.e, blank(uc.a))
+		})
+	}
+}
+
+func TestMetaFQN(t *testing.T) {
+	uu := map[string]struct {
+		m metav1.ObjectMeta
+		e string
+	}{
+		"full": {metav1.ObjectMeta{Namespace: "fred", Name: "blee"}, "fred/blee"},
+		"nons": {metav1.ObjectMeta{Name: "blee"}, "-/blee"},
+	}
+
+	for k := range uu {
+		uc := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, uc.e, client.MetaFQN(&uc.m))
+		})
+	}
+}
+
+func TestFQN(t *testing.T) {
+	uu := map[string]struct {
+		ns, n string
+		e     string
+	}{
+		"full": {ns: "fred", n: "blee", e: "fred/blee"},
+		"nons": {n: "blee", e: "blee"},
+	}
+
+	for k := range uu {
+		uc := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, uc.e, client.FQN(uc.ns, uc.n))
+		})
+	}
+}
+
+func TestMapToStr(t *testing.T) {
+	uu := []struct {
+		i map[string]string
+		e string
+	}{
+		{map[string]string{"blee": "duh", "aa": "bb"}, "aa=bb,blee=duh"},
+		{map[string]string{}, ""},
+	}
+	for _, u := range uu {
+		assert.Equal(t, u.e, mapToStr(u.i))
+	}
+}
+
+func BenchmarkMapToStr(b *testing.B) {
+	ll := map[string]string{
+		"blee": "duh",
+		"aa":   "bb",
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for range b.N {
+		mapToStr(ll)
+	}
+}
+
+func TestMapToStrSep(t *testing.T) {
+	m := map[string]string{"b": "2", "a": "1", "c": "3"}
+	uu := map[string]struct {
+		m             map[string]string
+		kvSep, pairSp string
+		e             string
+	}{
+		"empty": {
+			kvSep:  "=",
+			pairSp: ",",
+		},
+		"std": {
+			m:      m,
+			kvSep:  "=",
+			pairSp: ",",
+			e:      "a=1,b=2,c=3",
+		},
+		"lines": {
+			m:      m,
+			kvSep:  ": ",
+			pairSp: "\n",
+			e:      "a: 1\nb: 2\nc: 3",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, mapToStrSep(u.m, u.kvSep, u.pairSp))
+		})
+	}
+}
+
+func TestMapToIfc(t *testing.T) {
+	uu := map[string]struct {
+		m any
+		e string
+	}{
+		"nil": {},
+		"toast": {
+			m: "fred",
+		},
+		"empty": {
+			m: map[string]any{},
+		},
+		"std": {
+			m: map[string]any{"b": "2", "a": "1"},
+			e: "a=1 b=2",
+		},
+		"scalars": {
+			m: map[string]any{"i": int64(2), "f": 1.5, "b": true, "s": "fred", "n": nil},
+			e: "b=true f=1.5 i=2 n= s=fred",
+		},
+		"nested": {
+			m: map[string]any{
+				"m": map[string]any{"y": 2, "x": "1"},
+				"l": []any{"a", 1},
+			},
+			e: `l=["a",1] m={"x":"1","y":2}`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, mapToIfc(u.m))
+		})
+	}
+}
+
+func TestFilterLabels(t *testing.T) {
+	ll := map[string]string{
+		"app.kubernetes.io/name":             "nginx",
+		"app.kubernetes.io/version":          "1.2",
+		"pod-template-hash":                  "7d9c",
+		"controller-revision-hash":           "abc",
+		"statefulset.kubernetes.io/pod-name": "db-0",
+	}
+
+	uu := map[string]struct {
+		m        map[string]string
+		prefixes []string
+		include  bool
+		e        string
+	}{
+		"empty": {
+			prefixes: []string{"app.kubernetes.io/"},
+			include:  true,
+		},
+		"include": {
+			m:        ll,
+			prefixes: []string{"app.kubernetes.io/"},
+			include:  true,
+			e:        "app.kubernetes.io/name=nginx,app.kubernetes.io/version=1.2",
+		},
+		"exclude": {
+			m:        ll,
+			prefixes: []string{"app.kubernetes.io/", "statefulset.kubernetes.io/"},
+			e:        "controller-revision-hash=abc,pod-template-hash=7d9c",
+		},
+		"all-filtered": {
+			m:        ll,
+			prefixes: []string{"fred/"},
+			include:  true,
+		},
+		"no-prefixes": {
+			m: map[string]string{"a": "1"},
+			e: "a=1",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, mapToStr(FilterLabels(u.m, u.prefixes, u.include)))
+		})
+	}
+}
+
+func TestMaskValues(t *testing.T) {
+	uu := map[string]struct {
+		m, e map[string]string
+		eS   string
+	}{
+		"empty": {
+			e: map[string]string{},
+		},
+		"masked": {
+			m:  map[string]string{"password": "s3cr3t", "user": "fred"},
+			e:  map[string]string{"password": ValueMask, "user": ValueMask},
+			eS: "password=••••,user=••••",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, MaskValues(u.m))
+			assert.Equal(t, u.eS, mapToStrMasked(u.m))
+		})
+	}
+}
+
+func TestMaskValuesCustom(t *testing.T) {
+	defer func(m string) { ValueMask = m }(ValueMask)
+	ValueMask = "***"
+
+	m := map[string]string{"token": "abc"}
+	assert.Equal(t, "token=***", mapToStrMasked(m))
+	assert.Equal(t, "abc", m["token"])
+}
+
+func TestMapToStrN(t *testing.T) {
+	m := map[string]string{"c": "3", "a": "1", "d": "4", "b": "2"}
+	uu := map[string]struct {
+		m   map[string]string
+		max int
+		e   string
+	}{
+		"empty": {
+			max: 2,
+		},
+		"unbounded": {
+			m: m,
+			e: "a=1,b=2,c=3,d=4",
+		},
+		"fits": {
+			m:   m,
+			max: 4,
+			e:   "a=1,b=2,c=3,d=4",
+		},
+		"truncated": {
+			m:   m,
+			max: 2,
+			e:   "a=1,b=2,…(+2 more)",
+		},
+		"one": {
+			m:   m,
+			max: 1,
+			e:   "a=1,…(+3 more)",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, mapToStrN(u.m, u.max))
+		})
+	}
+}
+
+func TestRunesToNum(t *testing.T) {
+	uu := map[string]struct {
+		rr []rune
+		e  int64
+	}{
+		"0": {
+			rr: []rune(""),
+			e:  0,
+		},
+		"100": {
+			rr: []rune("100"),
+			e:  100,
+		},
+		"64": {
+			rr: []rune("64"),
+			e:  64,
+		},
+		"52640": {
+			rr: []rune("52640"),
+			e:  52640,
+		},
+		"max": {
+			rr: []rune("9223372036854775807"),
+			e:  math.MaxInt64,
+		},
+		"overflow": {
+			rr: []rune("1234567890123456789012345"),
+			e:  math.MaxInt64,
+		},
+		"letters": {
+			rr: []rune("1a2b3"),
+			e:  123,
+		},
+		"no-digits": {
+			rr: []rune("fred"),
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, runesToNum(u.rr))
+		})
+	}
+}
+
+func BenchmarkRunesToNum(b *testing.B) {
+	rr := []rune("5465")
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for range b.N {
+		runesToNum(rr)
+	}
+}
+
+func TestNaturalLess(t *testing.T) {
+	uu := map[string]struct {
+		a, b string
+		e    bool
+	}{
+		"same":           {a: "node1", b: "node1"},
+		"numeric":        {a: "node2", b: "node10", e: true},
+		"numeric-rev":    {a: "node10", b: "node2"},
+		"lexical":        {a: "node1", b: "pod1", e: true},
+		"prefix":         {a: "node", b: "node1", e: true},
+		"multi-runs":     {a: "pod-1-10", b: "pod-1-9"},
+		"numbers":        {a: "9", b: "10", e: true},
+		"leading-zeros":  {a: "007", b: "8", e: true},
+		"zeros-tie":      {a: "007", b: "7", e: true},
+		"zeros-tie-rev":  {a: "7", b: "007"},
+		"big":            {a: "99999999999999999999", b: "100000000000000000000", e: true},
+		"digit-vs-alpha": {a: "1a", b: "a1", e: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, NaturalLess(u.a, u.b))
+		})
+	}
+}
+
+func TestNaturalLessSort(t *testing.T) {
+	ss := []string{"node10", "node2", "node1", "node02", "node"}
+	sort.Slice(ss, func(i, j int) bool { return NaturalLess(ss[i], ss[j]) })
+
+	assert.Equal(t, []string{"node", "node1", "node02", "node2", "node10"}, ss)
+}
+
+func TestToMc(t *testing.T) {
+	uu := []struct {
+		v int64
+		e string
+	}{
+		{0, "0"},
+		{2, "2"},
+		{1_000, "1000"},
+	}
+
+	for _, u := range uu {
+		assert.Equal(t, u.e, toMc(u.v))
+	}
+}
+
+func TestToMOr(t *testing.T) {
+	uu := map[string]struct {
+		f    func(int64, string) string
+		v    int64
+		zero string
+		e    string
+	}{
+		"mc-zero": {
+			f:    toMcOr,
+			zero: "n/a",
+			e:    "n/a",
+		},
+		"mc": {
+			f:    toMcOr,
+			v:    250,
+			zero: "n/a",
+			e:    "250",
+		},
+		"mi-zero": {
+			f:    toMiOr,
+			zero: NAValue,
+			e:    NAValue,
+		},
+		"mi": {
+			f:    toMiOr,
+			v:    2 * client.MegaByte,
+			zero: NAValue,
+			e:    "2",
+		},
+		"mu-zero": {
+			f:    toMuOr,
+			zero: ZeroValue,
+			e:    ZeroValue,
+		},
+		"mu": {
+			f:    toMuOr,
+			v:    3,
+			zero: ZeroValue,
+			e:    "3",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, u.f(u.v, u.zero))
+		})
+	}
+}
+
+func TestToMu(t *testing.T) {
+	assert.Equal(t, NAValue, toMu(0))
+	assert.Equal(t, "2", toMu(2))
+}
+
+func TestFormatCPU(t *testing.T) {
+	uu := map[string]struct {
+		pref, unit string
+		v          int64
+		e          string
+	}{
+		"cores-zero": {
+			unit: client.CPUCores,
+			e:    "0",
+		},
+		"cores-small": {
+			unit: client.CPUCores,
+			v:    250,
+			e:    ".25",
+		},
+		"cores-large": {
+			unit: client.CPUCores,
+			v:    12_345,
+			e:    "12",
+		},
+		"mc-zero": {
+			unit: client.CPUMillicores,
+			e:    "0m",
+		},
+		"mc-small": {
+			unit: client.CPUMillicores,
+			v:    5,
+			e:    "5m",
+		},
+		"mc-large": {
+			unit: client.CPUMillicores,
+			v:    12_345,
+			e:    "12,345m",
+		},
+		"pref-override-cores": {
+			pref: client.CPUCores,
+			unit: client.CPUMillicores,
+			v:    1_500,
+			e:    "1.5",
+		},
+		"pref-override-mc": {
+			pref: client.CPUMillicores,
+			unit: client.CPUCores,
+			v:    1_500,
+			e:    "1,500m",
+		},
+	}
+
+	defer SetCPUUnit(CPUUnit())
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			SetCPUUnit(u.pref)
+			assert.Equal(t, u.e, FormatCPU(u.v, u.unit))
+		})
+	}
+}
+
+func TestDecimal(t *testing.T) {
+	uu := map[string]struct {
+		v int64
+		e string
+	}{
+		"negative": {v: -10, e: "0"},
+		"zero":     {v: 0, e: "0"},
+		"9":        {v: 9, e: "0"},
+		"10":       {v: 10, e: ".01"},
+		"99":       {v: 99, e: ".1"},
+		"100":      {v: 100, e: ".1"},
+		"250":      {v: 250, e: ".25"},
+		"995":      {v: 995, e: "1"},
+		"999":      {v: 999, e: "1"},
+		"1000":     {v: 1_000, e: "1"},
+		"1500":     {v: 1_500, e: "1.5"},
+		"9949":     {v: 9_949, e: "9.9"},
+		"9999":     {v: 9_999, e: "10"},
+		"10000":    {v: 10_000, e: "10"},
+		"10001":    {v: 10_001, e: "10"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, decimal(u.v))
+		})
+	}
+}
+
+func TestDecimalPctCollapsed(t *testing.T) {
+	uu := map[string]struct {
+		v, l   int64
+		e, eDP string
+	}{
+		"equal": {
+			v:   5_000,
+			l:   5_000,
+			e:   "5(100%)",
+			eDP: "5/5(100%)",
+		},
+		"zero-limit": {
+			v:   5_000,
+			e:   "5",
+			eDP: "5",
+		},
+		"normal": {
+			v:   2_500,
+			l:   5_000,
+			e:   "2.5/5(50%)",
+			eDP: "2.5/5(50%)",
+		},
+		"over": {
+			v:   6_000,
+			l:   5_000,
+			e:   "6/5(120%)",
+			eDP: "6/5(120%)",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, decimalPctCollapsed(u.v, u.l))
+			assert.Equal(t, u.eDP, decimalPct(u.v, u.l))
+		})
+	}
+}
+
+func TestTrendArrow(t *testing.T) {
+	uu := map[string]struct {
+		prev, cur int64
+		kind      ResourceKind
+		e, eD     string
+	}{
+		"first": {
+			prev: NoSample,
+			cur:  100,
+		},
+		"flat": {
+			prev: 100,
+			cur:  100,
+			e:    "→",
+			eD:   "→",
+		},
+		"cpu-up": {
+			prev: 500,
+			cur:  1_500,
+			e:    "↑",
+			eD:   "↑1",
+		},
+		"cpu-down": {
+			prev: 1_000,
+			cur:  500,
+			e:    "↓",
+			eD:   "↓.5",
+		},
+		"mem-up": {
+			prev: 0,
+			cur:  512 * client.MegaByte,
+			kind: ResourceMemory,
+			e:    "↑",
+			eD:   "↑512M",
+		},
+		"mem-down": {
+			prev: 2 * 1024 * client.MegaByte,
+			cur:  1024 * client.MegaByte,
+			kind: ResourceMemory,
+			e:    "↓",
+			eD:   "↓1G",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, TrendArrow(u.prev, u.cur))
+			assert.Equal(t, u.eD, TrendArrowDelta(u.prev, u.cur, u.kind))
+		})
+	}
+}
+
+func TestTrendArrowASCII(t *testing.T) {
+	defer func(u, d, f string) {
+		TrendUpGlyph, TrendDownGlyph, TrendFlatGlyph = u, d, f
+	}(TrendUpGlyph, TrendDownGlyph, TrendFlatGlyph)
+	TrendUpGlyph, TrendDownGlyph, TrendFlatGlyph = "^", "v", "="
+
+	assert.Equal(t, "^", TrendArrow(1, 2))
+	assert.Equal(t, "v", TrendArrow(2, 1))
+	assert.Equal(t, "=", TrendArrow(2, 2))
+}
+
+func TestSignedDeltas(t *testing.T) {
+	uu := map[string]struct {
+		v      int64
+		eB, eD string
+	}{
+		"zero": {
+			eB: "0",
+			eD: "0",
+		},
+		"up": {
+			v:  1_536,
+			eB: "+1.5K",
+			eD: "+1.5",
+		},
+		"down": {
+			v:  -2_000,
+			eB: "-2K",
+			eD: "-2",
+		},
+		"small": {
+			v:  250,
+			eB: "+250",
+			eD: "+.25",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.eB, signedBytes(u.v))
+			assert.Equal(t, u.eD, signedDecimal(u.v))
+		})
+	}
+}
+
+func TestRenderExtended(t *testing.T) {
+	uu := map[string]struct {
+		name, q string
+		e       string
+	}{
+		"zero": {
+			name: "nvidia.com/gpu",
+			q:    "0",
+			e:    ZeroValue,
+		},
+		"gpu": {
+			name: "nvidia.com/gpu",
+			q:    "4",
+			e:    "4",
+		},
+		"large": {
+			name: "example.com/widgets",
+			q:    "2k",
+			e:    "2000",
+		},
+		"fraction": {
+			name: "nvidia.com/gpu",
+			q:    "500m",
+			e:    "0.5",
+		},
+		"small-fraction": {
+			name: "nvidia.com/gpu",
+			q:    "1250m",
+			e:    "1.25",
+		},
+		"hugepages": {
+			name: "hugepages-2Mi",
+			q:    "512Mi",
+			e:    "512M",
+		},
+		"storage": {
+			name: "ephemeral-storage",
+			q:    "10Gi",
+			e:    "10G",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, RenderExtended(u.name, resource.MustParse(u.q)))
+		})
+	}
+}
+
+func TestCPUPct(t *testing.T) {
+	uu := map[string]struct {
+		pref string
+		v, l int64
+		e    string
+	}{
+		"default": {
+			v: 100,
+			l: 200,
+			e: ".1/.2(50%)",
+		},
+		"default-no-limit": {
+			v: 2_500,
+			e: "2.5",
+		},
+		"cores": {
+			pref: client.CPUCores,
+			v:    100,
+			l:    200,
+			e:    ".1/.2(50%)",
+		},
+		"millicores": {
+			pref: client.CPUMillicores,
+			v:    100,
+			l:    2_000,
+			e:    "100m/2,000m(5%)",
+		},
+		"millicores-no-limit": {
+			pref: client.CPUMillicores,
+			v:    100,
+			e:    "100m",
+		},
+	}
+
+	defer SetCPUUnit(CPUUnit())
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			SetCPUUnit(u.pref)
+			assert.Equal(t, u.e, cpuPct(u.v, u.l))
+		})
+	}
+}
+
+func TestCPUPctCollapsed(t *testing.T) {
+	uu := map[string]struct {
+		pref string
+		v, l int64
+		e    string
+	}{
+		"full": {
+			v: 5_000,
+			l: 5_000,
+			e: "5(100%)",
+		},
+		"headroom": {
+			v: 100,
+			l: 200,
+			e: ".1/.2(50%)",
+		},
+		"millicores": {
+			pref: client.CPUMillicores,
+			v:    100,
+			l:    100,
+			e:    "100m/100m(100%)",
+		},
+	}
+
+	defer SetCPUUnit(CPUUnit())
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			SetCPUUnit(u.pref)
+			assert.Equal(t, u.e, cpuPctCollapsed(u.v, u.l))
+		})
+	}
+}
+
+func TestBytesPct(t *testing.T) {
+	uu := map[string]struct {
+		v, l int64
+		e    string
+	}{
+		"zero": {
+			e: "0",
+		},
+		"zero-limit": {
+			v: 2 * client.MegaByte,
+			e: "2M",
+		},
+		"equal": {
+			v: 2 * client.MegaByte,
+			l: 2 * client.MegaByte,
+			e: "2M/2M(100%)",
+		},
+		"under": {
+			v: 512 * client.MegaByte,
+			l: 2048 * client.MegaByte,
+			e: "512M/2G(25%)",
+		},
+		"over": {
+			v: 3 * client.MegaByte,
+			l: 2 * client.MegaByte,
+			e: "3M/2M(150%)",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, bytesPct(u.v, u.l))
+		})
+	}
+}
+
+func TestMemReqLim(t *testing.T) {
+	uu := map[string]struct {
+		used, req, lim int64
+		e              string
+	}{
+		"none": {
+			used: 512 * client.MegaByte,
+			e:    "512M",
+		},
+		"req": {
+			used: 512 * client.MegaByte,
+			req:  256 * client.MegaByte,
+			e:    "512M/256M req(200%)",
+		},
+		"lim": {
+			used: 512 * client.MegaByte,
+			lim:  1024 * client.MegaByte,
+			e:    "512M/1G lim(50%)",
+		},
+		"both": {
+			used: 512 * client.MegaByte,
+			req:  256 * client.MegaByte,
+			lim:  1024 * client.MegaByte,
+			e:    "512M/256M req(200%)/1G lim(50%)",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, memReqLim(u.used, u.req, u.lim))
+		})
+	}
+}
+
+func TestRenderUsage(t *testing.T) {
+	uu := map[string]struct {
+		used, req, lim int64
+		kind           ResourceKind
+		e              string
+	}{
+		"cpu-limit": {
+			used: 100,
+			req:  50,
+			lim:  200,
+			e:    ".1/.2(50%)",
+		},
+		"cpu-request": {
+			used: 100,
+			req:  400,
+			e:    ".1/.4(25%)",
+		},
+		"cpu-usage": {
+			used: 2_500,
+			e:    "2.5",
+		},
+		"mem-limit": {
+			used: 512 * client.MegaByte,
+			req:  256 * client.MegaByte,
+			lim:  1024 * client.MegaByte,
+			kind: ResourceMemory,
+			e:    "512M/1G(50%)",
+		},
+		"mem-request": {
+			used: 512 * client.MegaByte,
+			req:  256 * client.MegaByte,
+			kind: ResourceMemory,
+			e:    "512M/256M(200%)",
+		},
+		"mem-usage": {
+			used: 512 * client.MegaByte,
+			kind: ResourceMemory,
+			e:    "512M",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, RenderUsage(u.used, u.req, u.lim, u.kind))
+		})
+	}
+}
+
+func TestFormatCapacity(t *testing.T) {
+	uu := map[string]struct {
+		alloc, capacity int64
+		kind            ResourceKind
+		e               string
+	}{
+		"no-capacity": {
+			alloc: 14_000,
+			e:     NAValue,
+		},
+		"cpu": {
+			alloc:    14_000,
+			capacity: 16_000,
+			e:        "14/16(88%)",
+		},
+		"cpu-full": {
+			alloc:    4_000,
+			capacity: 4_000,
+			e:        "4/4(100%)",
+		},
+		"mem": {
+			alloc:    60 * 1024 * client.MegaByte,
+			capacity: 64 * 1024 * client.MegaByte,
+			kind:     ResourceMemory,
+			e:        "60G/64G(94%)",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, FormatCapacity(u.alloc, u.capacity, u.kind))
+		})
+	}
+}
+
+func TestMemPctBucket(t *testing.T) {
+	uu := map[string]struct {
+		v, l int64
+		e    string
+		b    Bucket
+	}{
+		"no-limit": {
+			v: 512 * client.MegaByte,
+			e: "512M",
+			b: BucketUnknown,
+		},
+		"low": {
+			v: 512 * client.MegaByte,
+			l: 1024 * client.MegaByte,
+			e: "512M/1G(50%)",
+			b: BucketLow,
+		},
+		"med": {
+			v: 700,
+			l: 1_000,
+			e: "700/1000(70%)",
+			b: BucketMed,
+		},
+		"high": {
+			v: 950,
+			l: 1_000,
+			e: "950/1000(95%)",
+			b: BucketHigh,
+		},
+		"critical": {
+			v: 1_200,
+			l: 1_000,
+			e: "1.2K/1000(120%)",
+			b: BucketCritical,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			s, b := memPctBucket(u.v, u.l)
+			assert.Equal(t, u.e, s)
+			assert.Equal(t, u.b, b)
+			assert.Equal(t, u.e, memPct(u.v, u.l))
+		})
+	}
+}
+
+func TestRenderQuantity(t *testing.T) {
+	uu := map[string]struct {
+		pref string
+		q    string
+		kind ResourceKind
+		e    string
+	}{
+		"cpu-zero": {
+			q: "0",
+			e: "0",
+		},
+		"cpu-cores": {
+			q: "2",
+			e: "2",
+		},
+		"cpu-milli": {
+			q: "250m",
+			e: ".25",
+		},
+		"cpu-millicores": {
+			pref: client.CPUMillicores,
+			q:    "2500m",
+			e:    "2,500m",
+		},
+		"cpu-fractional-millicores": {
+			pref: client.CPUMillicores,
+			q:    "1500u",
+			e:    "1.5m",
+		},
+		"mem-zero": {
+			q:    "0",
+			kind: ResourceMemory,
+			e:    "0",
+		},
+		"mem-gi": {
+			q:    "2Gi",
+			kind: ResourceMemory,
+			e:    "2G",
+		},
+		"mem-mi": {
+			q:    "512Mi",
+			kind: ResourceMemory,
+			e:    "512M",
+		},
+	}
+
+	defer SetCPUUnit(CPUUnit())
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			SetCPUUnit(u.pref)
+			assert.Equal(t, u.e, RenderQuantity(resource.MustParse(u.q), u.kind))
+		})
+	}
+}
+
+func TestToHashColor(t *testing.T) {
+	uu := map[string]struct {
+		pp   []tcell.Color
+		h, e string
+	}{
+		"blank": {
+			pp: StringPalette,
+		},
+		"na": {
+			pp: StringPalette,
+			h:  NAValue,
+			e:  NAValue,
+		},
+		"hash": {
+			pp: []tcell.Color{tcell.ColorRed},
+			h:  "7fb78fb6d8",
+			e:  "[#ff0000::]7fb78fb6d8[-::]",
+		},
+		"no-palette": {
+			h: "7fb78fb6d8",
+			e: "7fb78fb6d8",
+		},
+	}
+
+	defer func(pp []tcell.Color) { StringPalette = pp }(StringPalette)
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			StringPalette = u.pp
+			assert.Equal(t, u.e, toHashColor(u.h))
+			assert.Equal(t, toHashColor(u.h), toHashColor(u.h))
+		})
+	}
+}
+
+func TestToTemplateHash(t *testing.T) {
+	uu := map[string]struct {
+		ll map[string]string
+		e  string
+	}{
+		"none": {
+			e: NAValue,
+		},
+		"hash": {
+			ll: map[string]string{"app": "nginx", "pod-template-hash": "7fb78fb6d8"},
+			e:  "7fb78fb6d8",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, toTemplateHash(u.ll))
+		})
+	}
+}
+
+func TestToMi(t *testing.T) {
+	uu := []struct {
+		v int64
+		e string
+	}{
+		{0, "0"},
+		{2 * client.MegaByte, "2"},
+		{1_000 * client.MegaByte, "1000"},
+	}
+
+	for _, u := range uu {
+		assert.Equal(t, u.e, toMi(u.v))
+	}
+}
+
+func TestHumanateBytes(t *testing.T) {
+	uu := map[string]struct {
+		v     uint64
+		sizes []string
+		e     string
+	}{
+		"max": {
+			v:     math.MaxUint64,
+			sizes: []string{"", "K", "M", "G", "T", "P", "E"},
+			e:     "16E",
+		},
+		"clamped": {
+			v:     math.MaxUint64,
+			sizes: []string{"", "K", "M"},
+			e:     "17592186044416M",
+		},
+		"single": {
+			v:     2048,
+			sizes: []string{" B"},
+			e:     "2048 B",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, humanateBytes(u.v, 1024, u.sizes))
+		})
+	}
+}
+
+func TestBytesPerSec(t *testing.T) {
+	uu := map[string]struct {
+		bytes int64
+		over  time.Duration
+		e     string
+	}{
+		"no-duration": {
+			bytes: 1_024,
+			e:     NAValue,
+		},
+		"negative-duration": {
+			bytes: 1_024,
+			over:  -time.Second,
+			e:     NAValue,
+		},
+		"zero": {
+			over: time.Second,
+			e:    "0/s",
+		},
+		"megs": {
+			bytes: 12 * client.MegaByte,
+			over:  10 * time.Second,
+			e:     "1.2M/s",
+		},
+		"sub-second": {
+			bytes: 512 * 1024,
+			over:  500 * time.Millisecond,
+			e:     "1M/s",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, BytesPerSec(u.bytes, u.over))
+		})
+	}
+}
+
+func TestHumanizeBytes(t *testing.T) {
+	uu := map[string]struct {
+		si bool
+		v  int64
+		e  string
+	}{
+		"zero": {
+			e: "0",
+		},
+		"tiny": {
+			v: 9,
+			e: "9 B",
+		},
+		"kib": {
+			v: 1_536,
+			e: "1.5K",
+		},
+		"mib": {
+			v: 200 * client.MegaByte,
+			e: "200M",
+		},
+		"negative": {
+			v: -1_536,
+			e: "-1.5K",
+		},
+		"negative-tiny": {
+			v: -1,
+			e: "-1 B",
+		},
+		"min": {
+			v: math.MinInt64,
+			e: "-8E",
+		},
+		"si-zero": {
+			si: true,
+			e:  "0",
+		},
+		"si-tiny": {
+			si: true,
+			v:  9,
+			e:  "9 B",
+		},
+		"si-bytes": {
+			si: true,
+			v:  999,
+			e:  "999 B",
+		},
+		"si-kb": {
+			si: true,
+			v:  1_500,
+			e:  "1.5KB",
+		},
+		"si-mb": {
+			si: true,
+			v:  200_000_000,
+			e:  "200MB",
+		},
+		"si-gb": {
+			si: true,
+			v:  2_000_000_000,
+			e:  "2GB",
+		},
+		"si-negative": {
+			si: true,
+			v:  -1_500,
+			e:  "-1.5KB",
+		},
+	}
+
+	defer func(b bool) { SIBytes = b }(SIBytes)
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			SIBytes = u.si
+			assert.Equal(t, u.e, humanizeBytes(u.v))
+		})
+	}
+}
+
+func TestAsThousands(t *testing.T) {
+	uu := map[string]struct {
+		tag language.Tag
+		n   int64
+		e   string
+	}{
+		"small": {
+			tag: language.English,
+			n:   999,
+			e:   "999",
+		},
+		"english": {
+			tag: language.English,
+			n:   1_234_567,
+			e:   "1,234,567",
+		},
+		"german": {
+			tag: language.German,
+			n:   1_234_567,
+			e:   "1.234.567",
+		},
+	}
+
+	defer SetNumberLocale(language.English)
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			SetNumberLocale(u.tag)
+			assert.Equal(t, u.e, AsThousands(u.n))
+		})
+	}
+}
+
+func TestGroupThousands(t *testing.T) {
+	uu := map[string]struct {
+		n int64
+		e string
+	}{
+		"zero":     {e: "0"},
+		"small":    {n: 999, e: "999"},
+		"thousand": {n: 1_000, e: "1,000"},
+		"millions": {n: 1_234_567, e: "1,234,567"},
+		"even":     {n: 123_456_789, e: "123,456,789"},
+		"negative": {n: -1_234, e: "-1,234"},
+		"neg-even": {n: -123_456, e: "-123,456"},
+		"max":      {n: math.MaxInt64, e: "9,223,372,036,854,775,807"},
+		"min":      {n: math.MinInt64, e: "-9,223,372,036,854,775,808"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, groupThousands(u.n))
+			assert.Equal(t, message.NewPrinter(language.English).Sprintf("%d", u.n), groupThousands(u.n))
+		})
+	}
+}
+
+func TestAsThousandsSeparator(t *testing.T) {
+	uu := map[string]struct {
+		sep string
+		n   int64
+		e   string
+	}{
+		"space": {
+			sep: " ",
+			n:   1_234_567,
+			e:   "1 234 567",
+		},
+		"apostrophe": {
+			sep: "'",
+			n:   1_234_567,
+			e:   "1'234'567",
+		},
+		"small": {
+			sep: "'",
+			n:   999,
+			e:   "999",
+		},
+		"even": {
+			sep: "'",
+			n:   123_456,
+			e:   "123'456",
+		},
+		"negative": {
+			sep: " ",
+			n:   -1_234_567,
+			e:   "-1 234 567",
+		},
+		"negative-small": {
+			sep: " ",
+			n:   -123,
+			e:   "-123",
+		},
+		"min": {
+			sep: "'",
+			n:   math.MinInt64,
+			e:   "-9'223'372'036'854'775'808",
+		},
+		"locale": {
+			n: 1_234_567,
+			e: "1,234,567",
+		},
+	}
+
+	defer SetThousandsSeparator("")
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			SetThousandsSeparator(u.sep)
+			assert.Equal(t, u.e, AsThousands(u.n))
+		})
+	}
+}
+
+func TestAsPerc(t *testing.T) {
+	uu := map[string]struct {
+		p, e string
+	}{
+		"blank":   {},
+		"perc":    {p: "50%", e: "(50%)"},
+		"wrapped": {p: "(50%)", e: "(50%)"},
+		"number":  {p: "50", e: "(50%)"},
+		"decimal": {p: "0.5%", e: "(0.5%)"},
+		"toast":   {p: "n/a", e: "n/a"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, AsPerc(u.p))
+			assert.Equal(t, u.e, AsPerc(AsPerc(u.p)))
+		})
+	}
+}
+
+func TestPrintPercClamped(t *testing.T) {
+	uu := map[string]struct {
+		p          float64
+		clamp      int
+		eRaw, eClp string
+	}{
+		"negative": {p: -3, clamp: 0, eRaw: "-3%", eClp: "0%"},
+		"zero":     {p: 0, clamp: 0, eRaw: "0%", eClp: "0%"},
+		"mid":      {p: 49.6, clamp: 50, eRaw: "50%", eClp: "50%"},
+		"full":     {p: 100, clamp: 100, eRaw: "100%", eClp: "100%"},
+		"burst":    {p: 150, clamp: 100, eRaw: "150%", eClp: "100%"},
+	}
+
+	defer func(b bool) { ClampPercents = b }(ClampPercents)
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.clamp, clampPct(u.p))
+			ClampPercents = false
+			assert.Equal(t, u.eRaw, PrintPercClamped(u.p))
+			ClampPercents = true
+			assert.Equal(t, u.eClp, PrintPercClamped(u.p))
+		})
+	}
+}
+
+func TestPercBar(t *testing.T) {
+	uu := map[string]struct {
+		p, cells int
+		e        string
+	}{
+		"zero": {
+			cells: 5,
+			e:     "░░░░░ 0%",
+		},
+		"partial": {
+			p:     60,
+			cells: 5,
+			e:     "▇▇▇░░ 60%",
+		},
+		"round": {
+			p:     55,
+			cells: 10,
+			e:     "▇▇▇▇▇▇░░░░ 55%",
+		},
+		"full": {
+			p:     100,
+			cells: 4,
+			e:     "▇▇▇▇ 100%",
+		},
+		"over": {
+			p:     250,
+			cells: 4,
+			e:     "▇▇▇▇ 100%",
+		},
+		"under": {
+			p:     -10,
+			cells: 2,
+			e:     "░░ 0%",
+		},
+		"no-cells": {
+			p: 50,
+			e: " 50%",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, PercBar(u.p, u.cells))
+		})
+	}
+}
+
+func TestPercBarASCII(t *testing.T) {
+	defer func(f, e string) { BarFullGlyph, BarEmptyGlyph = f, e }(BarFullGlyph, BarEmptyGlyph)
+	BarFullGlyph, BarEmptyGlyph = "#", "-"
+
+	assert.Equal(t, "##--- 40%", PercBar(40, 5))
+}
+
+func TestPrintPercF(t *testing.T) {
+	uu := map[string]struct {
+		p float64
+		e string
+	}{
+		"zero":     {e: "0%"},
+		"low":      {p: 0.5, e: "0.5%"},
+		"round":    {p: 42, e: "42%"},
+		"rounding": {p: 12.34, e: "12.3%"},
+		"up":       {p: 99.96, e: "100%"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, PrintPercF(u.p))
+		})
+	}
+}
+
+func TestHumanizeCount(t *testing.T) {
+	uu := map[string]struct {
+		n int64
+		e string
+	}{
+		"zero":     {e: "0"},
+		"small":    {n: 7, e: "7"},
+		"hundreds": {n: 999, e: "999"},
+		"k":        {n: 1_000, e: "1k"},
+		"12k":      {n: 12_345, e: "12k"},
+		"m":        {n: 1_234_567, e: "1.2M"},
+		"g":        {n: 3_000_000_000, e: "3G"},
+		"negative": {n: -12_345, e: "-12k"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, humanizeCount(u.n))
+		})
+	}
+}
+
+func TestAsThousandsConcurrent(t *testing.T) {
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 100 {
+				assert.Equal(t, "1,000", AsThousands(1_000))
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func BenchmarkAsThousands(b *testing.B) {
+	b.ReportAllocs()
+	for range b.N {
+		_ = AsThousands(1_234_567)
+	}
+}
+
+func BenchmarkAsThousandsPrinter(b *testing.B) {
+	p := message.NewPrinter(language.English)
+	b.ResetTimer()
+	b.ReportAllocs()
+	for range b.N {
+		_ = p.Sprintf("%d", 1_234_567)
+	}
+}
+
+func BenchmarkGroupThousands(b *testing.B) {
+	b.ReportAllocs()
+	for range b.N {
+		_ = groupThousands(1_234_567)
+	}
+}
+
+func BenchmarkAsThousandsNewPrinter(b *testing.B) {
+	b.ReportAllocs()
+	for range b.N {
+		_ = message.NewPrinter(language.English).Sprintf("%d", 1_234_567)
+	}
+}
+
+func TestIntToStr(t *testing.T) {
+	uu := []struct {
+		v int
+		e string
+	}{
+		{0, "0"},
+		{10, "10"},
+	}
+
+	for _, u := range uu {
+		assert.Equal(t, u.e, IntToStr(u.v))
+	}
+}
+
+func BenchmarkIntToStr(b *testing.B) {
+	v := 10
+	b.ResetTimer()
+	b.ReportAllocs()
+	for range b.N {
+		IntToStr(v)
+	}
+}
+
+func TestFormatCondition(t *testing.T) {
+	cc := []metav1.Condition{
+		{Type: "Available", Status: metav1.ConditionTrue, Reason: "MinimumReplicasAvailable"},
+		{Type: "Ready", Status: metav1.ConditionFalse},
+		{Type: "Synced"},
+	}
+
+	uu := map[string]struct {
+		cc []metav1.Condition
+		t  string
+		e  string
+	}{
+		"empty": {
+			t: "Ready",
+			e: NAValue,
+		},
+		"absent": {
+			cc: cc,
+			t:  "Progressing",
+			e:  NAValue,
+		},
+		"reason": {
+			cc: cc,
+			t:  "Available",
+			e:  "True (MinimumReplicasAvailable)",
+		},
+		"no-reason": {
+			cc: cc,
+			t:  "Ready",
+			e:  "False",
+		},
+		"no-status": {
+			cc: cc,
+			t:  "Synced",
+			e:  UnknownValue,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, FormatCondition(u.cc, u.t))
+		})
+	}
+}
+
+func TestFormatOwner(t *testing.T) {
+	yes, no := true, false
+
+	uu := map[string]struct {
+		oo []metav1.OwnerReference
+		e  string
+	}{
+		"empty": {
+			e: NAValue,
+		},
+		"single": {
+			oo: []metav1.OwnerReference{{Kind: "ReplicaSet", Name: "nginx-7d9c"}},
+			e:  "ReplicaSet/nginx-7d9c",
+		},
+		"controller": {
+			oo: []metav1.OwnerReference{
+				{Kind: "ConfigMap", Name: "cm", Controller: &no},
+				{Kind: "Deployment", Name: "nginx", Controller: &yes},
+				{Kind: "Secret", Name: "s"},
+			},
+			e: "Deployment/nginx,+2",
+		},
+		"no-controller": {
+			oo: []metav1.OwnerReference{
+				{Kind: "Node", Name: "n1"},
+				{Kind: "ConfigMap", Name: "cm"},
+			},
+			e: "Node/n1,+1",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, FormatOwner(u.oo))
+		})
+	}
+}
+
+func TestFormatEnvFrom(t *testing.T) {
+	cm := func(n string) *v1.ConfigMapEnvSource {
+		return &v1.ConfigMapEnvSource{LocalObjectReference: v1.LocalObjectReference{Name: n}}
+	}
+	sec := func(n string) *v1.SecretEnvSource {
+		return &v1.SecretEnvSource{LocalObjectReference: v1.LocalObjectReference{Name: n}}
+	}
+
+	uu := map[string]struct {
+		ee []v1.EnvFromSource
+		e  string
+	}{
+		"empty": {
+			e: NAValue,
+		},
+		"sorted": {
+			ee: []v1.EnvFromSource{
+				{SecretRef: sec("bar")},
+				{ConfigMapRef: cm("foo")},
+			},
+			e: "cm:foo,secret:bar",
+		},
+		"prefix": {
+			ee: []v1.EnvFromSource{
+				{ConfigMapRef: cm("foo"), Prefix: "APP_"},
+			},
+			e: "cm:foo(APP_)",
+		},
+		"no-ref": {
+			ee: []v1.EnvFromSource{{Prefix: "APP_"}},
+			e:  NAValue,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, FormatEnvFrom(u.ee))
+		})
+	}
+}
+
+func TestFormatFinalizers(t *testing.T) {
+	uu := map[string]struct {
+		ff []string
+		e  string
+	}{
+		"empty": {
+			e: MissingValue,
+		},
+		"blanks": {
+			ff: []string{""},
+			e:  MissingValue,
+		},
+		"sorted": {
+			ff: []string{"kubernetes.io/pvc-protection", "foregroundDeletion"},
+			e:  "foregroundDeletion,kubernetes.io/pvc-protection",
+		},
+		"elided": {
+			ff: []string{
+				"kubernetes.io/pvc-protection",
+				"foregroundDeletion",
+				"example.com/cleanup-dns-records",
+				"orphan",
+			},
+			e: "example.com/cleanup-dns-records,…(+3)",
+		},
+		"long-first": {
+			ff: []string{
+				"a-really-long-finalizer.example.com/that-goes-on-and-on",
+				"b-finalizer",
+			},
+			e: "a-really-long-finalizer.example.com/that-go…,…(+1)",
+		},
+		"single-long": {
+			ff: []string{"a-really-long-finalizer.example.com/that-goes-on-and-on"},
+			e:  "a-really-long-finalizer.example.com/that-goes-on-…",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			s := FormatFinalizers(u.ff)
+			assert.Equal(t, u.e, s)
+			assert.LessOrEqual(t, runewidth.StringWidth(s), maxFinalizersWidth)
+		})
+	}
+}
+
+func TestFormatTaints(t *testing.T) {
+	uu := map[string]struct {
+		tt []v1.Taint
+		e  string
+	}{
+		"empty": {
+			e: MissingValue,
+		},
+		"value": {
+			tt: []v1.Taint{{Key: "gpu", Value: "true", Effect: v1.TaintEffectNoSchedule}},
+			e:  "gpu=true:NoSchedule",
+		},
+		"no-value": {
+			tt: []v1.Taint{{Key: "node-role.kubernetes.io/control-plane", Effect: v1.TaintEffectNoSchedule}},
+			e:  "node-role.kubernetes.io/control-plane:NoSchedule",
+		},
+		"sorted": {
+			tt: []v1.Taint{
+				{Key: "zone", Value: "a", Effect: v1.TaintEffectPreferNoSchedule},
+				{Key: "dedicated", Value: "db", Effect: v1.TaintEffectNoExecute},
+			},
+			e: "dedicated=db:NoExecute,zone=a:PreferNoSchedule",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, FormatTaints(u.tt))
+		})
+	}
+}
+
+func TestFormatTolerations(t *testing.T) {
+	secs := int64(300)
+
+	uu := map[string]struct {
+		tt []v1.Toleration
+		e  string
+	}{
+		"empty": {
+			e: MissingValue,
+		},
+		"equal": {
+			tt: []v1.Toleration{{Key: "gpu", Operator: v1.TolerationOpEqual, Value: "true", Effect: v1.TaintEffectNoSchedule}},
+			e:  "gpu=true:NoSchedule",
+		},
+		"exists": {
+			tt: []v1.Toleration{{Key: "gpu", Operator: v1.TolerationOpExists, Effect: v1.TaintEffectNoSchedule}},
+			e:  "gpu:NoSchedule",
+		},
+		"all": {
+			tt: []v1.Toleration{{Operator: v1.TolerationOpExists}},
+			e:  "*",
+		},
+		"seconds": {
+			tt: []v1.Toleration{{
+				Key:               "node.kubernetes.io/not-ready",
+				Operator:          v1.TolerationOpExists,
+				Effect:            v1.TaintEffectNoExecute,
+				TolerationSeconds: &secs,
+			}},
+			e: "node.kubernetes.io/not-ready:NoExecute(300s)",
+		},
+		"sorted": {
+			tt: []v1.Toleration{
+				{Key: "zone", Value: "a"},
+				{Key: "dedicated", Value: "db", Effect: v1.TaintEffectNoExecute},
+			},
+			e: "dedicated=db:NoExecute,zone=a",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, FormatTolerations(u.tt))
+		})
+	}
+}
+
+func TestFormatPorts(t *testing.T) {
+	uu := map[string]struct {
+		pp    []v1.ContainerPort
+		named bool
+		e     string
+	}{
+		"empty": {
+			e: NAValue,
+		},
+		"sorted": {
+			pp: []v1.ContainerPort{
+				{Name: "https", ContainerPort: 443, Protocol: v1.ProtocolTCP},
+				{Name: "http", ContainerPort: 80, Protocol: v1.ProtocolTCP},
+			},
+			e: "80/TCP,443/TCP",
+		},
+		"named": {
+			pp: []v1.ContainerPort{
+				{Name: "https", ContainerPort: 443, Protocol: v1.ProtocolTCP},
+				{ContainerPort: 9090},
+				{Name: "dns", ContainerPort: 53, Protocol: v1.ProtocolUDP},
+			},
+			named: true,
+			e:     "dns:53/UDP,https:443/TCP,9090/TCP",
+		},
+		"same-port": {
+			pp: []v1.ContainerPort{
+				{ContainerPort: 53, Protocol: v1.ProtocolUDP},
+				{ContainerPort: 53, Protocol: v1.ProtocolTCP},
+			},
+			e: "53/TCP,53/UDP",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, FormatPorts(u.pp, u.named))
+		})
+	}
+}
+
+func TestFormatServicePorts(t *testing.T) {
+	uu := map[string]struct {
+		pp    []v1.ServicePort
+		named bool
+		e     string
+	}{
+		"empty": {
+			e: NAValue,
+		},
+		"plain": {
+			pp: []v1.ServicePort{
+				{Name: "https", Port: 443, NodePort: 30443, Protocol: v1.ProtocolTCP},
+				{Name: "http", Port: 80, NodePort: 30080, Protocol: v1.ProtocolTCP},
+			},
+			e: "80/TCP,443/TCP",
+		},
+		"named": {
+			pp: []v1.ServicePort{
+				{Name: "https", Port: 443, Protocol: v1.ProtocolTCP},
+				{Name: "http", Port: 80, Protocol: v1.ProtocolTCP},
+			},
+			named: true,
+			e:     "http:80/TCP,https:443/TCP",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, FormatServicePorts(u.pp, u.named))
+		})
+	}
+}
+
+func TestFormatIPs(t *testing.T) {
+	uu := map[string]struct {
+		ips []string
+		w   int
+		e   string
+	}{
+		"empty": {
+			w: 10,
+		},
+		"single": {
+			ips: []string{"10.0.0.1"},
+			w:   20,
+			e:   "10.0.0.1",
+		},
+		"fits": {
+			ips: []string{"10.0.0.1", "", "10.0.0.2"},
+			w:   20,
+			e:   "10.0.0.1,10.0.0.2",
+		},
+		"unbounded": {
+			ips: []string{"10.0.0.1", "fd00:10:244::1"},
+			e:   "10.0.0.1,fd00:10:244::1",
+		},
+		"dual-stack": {
+			ips: []string{"10.0.0.1", "fd00:10:244::1"},
+			w:   12,
+			e:   "10.0.0.1,+1",
+		},
+		"long-first": {
+			ips: []string{"fd00:10:244:1:2:3:4:5", "10.0.0.1", "10.0.0.2"},
+			w:   12,
+			e:   "fd00:10:…,+2",
+		},
+		"single-long": {
+			ips: []string{"fd00:10:244:1:2:3:4:5", ""},
+			w:   8,
+			e:   "fd00:10…",
+		},
+		"tiny": {
+			ips: []string{"10.0.0.1", "10.0.0.2"},
+			w:   3,
+			e:   "+1",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, FormatIPs(u.ips, u.w))
+		})
+	}
+}
+
+func TestColorForString(t *testing.T) {
+	// Mapping must remain stable across runs.
+	assert.Equal(t, tcell.ColorSpringGreen, ColorForString("nginx"))
+
+	seen := make(map[tcell.Color]struct{})
+	for _, s := range []string{"nginx", "redis", "postgres", "kafka", "envoy", "coredns"} {
+		seen[ColorForString(s)] = struct{}{}
+	}
+	assert.Greater(t, len(seen), 1)
+}
+
+func TestColorForStringPalette(t *testing.T) {
+	defer func(pp []tcell.Color) { StringPalette = pp }(StringPalette)
+
+	StringPalette = []tcell.Color{tcell.ColorRed}
+	assert.Equal(t, tcell.ColorRed, ColorForString("nginx"))
+
+	StringPalette = nil
+	assert.Equal(t, tcell.ColorDefault, ColorForString("nginx"))
+}
+
+func TestReadySummary(t *testing.T) {
+	uu := map[string]struct {
+		ss []v1.ContainerStatus
+		e  string
+		b  Bucket
+	}{
+		"empty": {
+			e: "0/0",
+			b: BucketUnknown,
+		},
+		"all": {
+			ss: []v1.ContainerStatus{{Ready: true}, {Ready: true}},
+			e:  "2/2",
+			b:  BucketLow,
+		},
+		"partial": {
+			ss: []v1.ContainerStatus{{Ready: true}, {}, {Ready: true}},
+			e:  "2/3",
+			b:  BucketHigh,
+		},
+		"none": {
+			ss: []v1.ContainerStatus{{}, {}},
+			e:  "0/2",
+			b:  BucketCritical,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, ReadySummary(u.ss))
+			assert.Equal(t, u.b, ReadyBucket(u.ss))
+		})
+	}
+}
+
+func TestExtractImages(t *testing.T) {
+	uu := map[string]struct {
+		spec v1.PodSpec
+		e    []string
+	}{
+		"empty": {
+			e: []string{},
+		},
+		"containers": {
+			spec: v1.PodSpec{
+				Containers: []v1.Container{{Image: "i1"}, {Image: "i2"}},
+			},
+			e: []string{"i1", "i2"},
+		},
+		"all": {
+			spec: v1.PodSpec{
+				Containers:     []v1.Container{{Image: "i1"}},
+				InitContainers: []v1.Container{{Image: "i2"}, {Image: "i3"}},
+				EphemeralContainers: []v1.EphemeralContainer{
+					{EphemeralContainerCommon: v1.EphemeralContainerCommon{Image: "i4"}},
+				},
+			},
+			e: []string{"i1", "i2", "i3", "i4"},
+		},
+		"dups": {
+			spec: v1.PodSpec{
+				Containers:     []v1.Container{{Image: "i1"}, {Image: "i1"}},
+				InitContainers: []v1.Container{{Image: "i2"}, {Image: "i1"}},
+				EphemeralContainers: []v1.EphemeralContainer{
+					{EphemeralContainerCommon: v1.EphemeralContainerCommon{Image: "i2"}},
+				},
+			},
+			e: []string{"i1", "i2"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, ExtractImages(&u.spec))
+		})
+	}
+}
+
+func TestExtractImageRefs(t *testing.T) {
+	const dig = "@sha256:2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"
+	spec := v1.PodSpec{
+		Containers: []v1.Container{
+			{Image: "nginx:1.27" + dig},
+			{Image: "nginx:1.27"},
+			{Image: "redis" + dig},
+		},
+		InitContainers: []v1.Container{{Image: "registry:5000/busybox:1.36"}},
+	}
+
+	uu := map[string]struct {
+		strip bool
+		e     []string
+	}{
+		"raw": {
+			e: []string{"nginx:1.27" + dig, "nginx:1.27", "redis" + dig, "registry:5000/busybox:1.36"},
+		},
+		"stripped": {
+			strip: true,
+			e:     []string{"nginx:1.27", "redis", "registry:5000/busybox:1.36"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, ExtractImageRefs(&spec, u.strip))
+		})
+	}
+}
+
+func TestSkipVulScan(t *testing.T) {
+	uu := map[string]struct {
+		ann map[string]string
+		e   bool
+	}{
+		"none": {},
+		"other": {
+			ann: map[string]string{"fred": "true"},
+		},
+		"skip": {
+			ann: map[string]string{SkipVulScanAnnotation: "true"},
+			e:   true,
+		},
+		"no-skip": {
+			ann: map[string]string{SkipVulScanAnnotation: "false"},
+		},
+		"toast": {
+			ann: map[string]string{SkipVulScanAnnotation: "blee"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, skipVulScan(u.ann))
+		})
+	}
+}
+
+func TestComputeVulScoreNoScanner(t *testing.T) {
+	assert.Equal(t, NAValue, computeVulScore("default", nil, nil, &v1.PodSpec{}))
+}
+
+func TestToVulColor(t *testing.T) {
+	uu := map[string]struct {
+		s, e string
+	}{
+		"none": {
+			s: vul.NoScore,
+			e: vul.NoScore,
+		},
+		"na": {
+			s: NAValue,
+			e: NAValue,
+		},
+		"critical": {
+			s: "110000",
+			e: "[red::]110000[-::]",
+		},
+		"high": {
+			s: "010100",
+			e: "[orange::]010100[-::]",
+		},
+		"medium": {
+			s: "001000",
+			e: "[yellow::]001000[-::]",
+		},
+		"low": {
+			s: "000100",
+			e: "000100",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, toVulColor(u.s))
+		})
+	}
+}
+
+func TestAggregateVulScore(t *testing.T) {
+	uu := map[string]struct {
+		ss []string
+		e  string
+	}{
+		"empty": {
+			e: vul.NoScore,
+		},
+		"single": {
+			ss: []string{"001000"},
+			e:  "001000",
+		},
+		"critical": {
+			ss: []string{"011100", "100000", "001000"},
+			e:  "100000",
+		},
+		"high": {
+			ss: []string{"000100", "010000", "001000"},
+			e:  "010000",
+		},
+		"tie": {
+			ss: []string{"100000", "101000"},
+			e:  "101000",
+		},
+		"order": {
+			ss: []string{"101000", "100000"},
+			e:  "101000",
+		},
+		"malformed": {
+			ss: []string{"", "n/a", "1111111", "000100"},
+			e:  "000100",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, AggregateVulScore(u.ss))
+		})
 	}
 }
 
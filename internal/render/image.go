@@ -0,0 +1,120 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"sort"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+// ContainerKind tracks a pod container kind.
+type ContainerKind int
+
+const (
+	// RegularContainer represents a regular container.
+	RegularContainer ContainerKind = iota
+
+	// InitContainer represents an init container.
+	InitContainer
+
+	// EphemeralContainer represents an ephemeral container.
+	EphemeralContainer
+)
+
+// ImageRef tracks a container image.
+type ImageRef struct {
+	Container string
+	Image     string
+	Kind      ContainerKind
+}
+
+// ExtractImagePairs returns images by container in containers, init containers then ephemeral containers order.
+func ExtractImagePairs(spec *v1.PodSpec) []ImageRef {
+	rr := make([]ImageRef, 0, len(spec.Containers)+len(spec.InitContainers)+len(spec.EphemeralContainers))
+	for _, c := range spec.Containers {
+		rr = append(rr, ImageRef{Container: c.Name, Image: c.Image, Kind: RegularContainer})
+	}
+	for _, c := range spec.InitContainers {
+		rr = append(rr, ImageRef{Container: c.Name, Image: c.Image, Kind: InitContainer})
+	}
+	for _, c := range spec.EphemeralContainers {
+		rr = append(rr, ImageRef{Container: c.Name, Image: c.Image, Kind: EphemeralContainer})
+	}
+
+	return rr
+}
+
+const (
+	// DefaultRegistry tracks the registry used by images without a registry host.
+	DefaultRegistry = "docker.io"
+
+	// DefaultImageTag tracks the tag used by images without a tag or digest.
+	DefaultImageTag = "latest"
+)
+
+// ParseImageRef splits an image reference into its registry, repository, tag and digest.
+// Images without a registry host default to docker.io and images without a tag or digest to latest.
+func ParseImageRef(ref string) (registry, repo, tag, digest string) {
+	if i := strings.Index(ref, "@"); i >= 0 {
+		ref, digest = ref[:i], ref[i+1:]
+	}
+	if i := strings.LastIndex(ref, ":"); i > strings.LastIndex(ref, "/") {
+		ref, tag = ref[:i], ref[i+1:]
+	}
+	if tag == "" && digest == "" {
+		tag = DefaultImageTag
+	}
+
+	registry, repo = DefaultRegistry, ref
+	if i := strings.Index(ref, "/"); i >= 0 && isRegistryHost(ref[:i]) {
+		registry, repo = ref[:i], ref[i+1:]
+	}
+
+	return
+}
+
+// DefaultPullPolicy returns the pull policy Kubernetes defaults to for an image.
+// Latest or untagged images are always pulled.
+func DefaultPullPolicy(image string) v1.PullPolicy {
+	if _, _, tag, _ := ParseImageRef(image); tag == DefaultImageTag {
+		return v1.PullAlways
+	}
+
+	return v1.PullIfNotPresent
+}
+
+// FormatImageStatus returns a container image pull policy and whether the image
+// was resolved ie Always (pulled) or IfNotPresent (cached). Blank policies
+// render the image default policy.
+func FormatImageStatus(image string, policy v1.PullPolicy, imageID string) string {
+	if policy == "" {
+		policy = DefaultPullPolicy(image)
+	}
+	status := "pending"
+	if imageID != "" {
+		status = "cached"
+		if policy == v1.PullAlways {
+			status = "pulled"
+		}
+	}
+
+	return string(policy) + " (" + status + ")"
+}
+
+func isRegistryHost(s string) bool {
+	return s == "localhost" || strings.ContainsAny(s, ".:")
+}
+
+// DisplayImages returns a pod unique images sorted and comma separated.
+func DisplayImages(spec *v1.PodSpec) string {
+	ii := ExtractImages(spec)
+	if len(ii) == 0 {
+		return NAValue
+	}
+	sort.Strings(ii)
+
+	return join(ii, ",")
+}
@@ -0,0 +1,203 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+)
+
+func TestExtractImagePairs(t *testing.T) {
+	uu := map[string]struct {
+		spec v1.PodSpec
+		e    []render.ImageRef
+	}{
+		"empty": {
+			e: []render.ImageRef{},
+		},
+		"all": {
+			spec: v1.PodSpec{
+				Containers: []v1.Container{
+					{Name: "c1", Image: "i1"},
+					{Name: "c2", Image: "i1"},
+				},
+				InitContainers: []v1.Container{{Name: "i1", Image: "i2"}},
+				EphemeralContainers: []v1.EphemeralContainer{
+					{EphemeralContainerCommon: v1.EphemeralContainerCommon{Name: "e1", Image: "i3"}},
+				},
+			},
+			e: []render.ImageRef{
+				{Container: "c1", Image: "i1", Kind: render.RegularContainer},
+				{Container: "c2", Image: "i1", Kind: render.RegularContainer},
+				{Container: "i1", Image: "i2", Kind: render.InitContainer},
+				{Container: "e1", Image: "i3", Kind: render.EphemeralContainer},
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, render.ExtractImagePairs(&u.spec))
+		})
+	}
+}
+
+func TestParseImageRef(t *testing.T) {
+	const dig = "sha256:2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"
+
+	uu := map[string]struct {
+		ref                         string
+		registry, repo, tag, digest string
+	}{
+		"bare": {
+			ref:      "nginx",
+			registry: "docker.io",
+			repo:     "nginx",
+			tag:      "latest",
+		},
+		"tag": {
+			ref:      "nginx:1.27",
+			registry: "docker.io",
+			repo:     "nginx",
+			tag:      "1.27",
+		},
+		"org": {
+			ref:      "bitnami/redis:7.2",
+			registry: "docker.io",
+			repo:     "bitnami/redis",
+			tag:      "7.2",
+		},
+		"registry": {
+			ref:      "ghcr.io/derailed/k9s:v0.40.0",
+			registry: "ghcr.io",
+			repo:     "derailed/k9s",
+			tag:      "v0.40.0",
+		},
+		"registry-port": {
+			ref:      "registry:5000/team/app",
+			registry: "registry:5000",
+			repo:     "team/app",
+			tag:      "latest",
+		},
+		"localhost": {
+			ref:      "localhost/app:dev",
+			registry: "localhost",
+			repo:     "app",
+			tag:      "dev",
+		},
+		"digest": {
+			ref:      "nginx@" + dig,
+			registry: "docker.io",
+			repo:     "nginx",
+			digest:   dig,
+		},
+		"tag-digest": {
+			ref:      "quay.io/app/img:1.2@" + dig,
+			registry: "quay.io",
+			repo:     "app/img",
+			tag:      "1.2",
+			digest:   dig,
+		},
+		"port-digest": {
+			ref:      "registry:5000/app@" + dig,
+			registry: "registry:5000",
+			repo:     "app",
+			digest:   dig,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			registry, repo, tag, digest := render.ParseImageRef(u.ref)
+			assert.Equal(t, u.registry, registry)
+			assert.Equal(t, u.repo, repo)
+			assert.Equal(t, u.tag, tag)
+			assert.Equal(t, u.digest, digest)
+		})
+	}
+}
+
+func TestDisplayImages(t *testing.T) {
+	uu := map[string]struct {
+		spec v1.PodSpec
+		e    string
+	}{
+		"empty": {
+			e: render.NAValue,
+		},
+		"sorted": {
+			spec: v1.PodSpec{
+				Containers:     []v1.Container{{Image: "redis"}, {Image: "nginx"}, {Image: "redis"}},
+				InitContainers: []v1.Container{{Image: "busybox"}},
+			},
+			e: "busybox,nginx,redis",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, render.DisplayImages(&u.spec))
+		})
+	}
+}
+
+func TestFormatImageStatus(t *testing.T) {
+	uu := map[string]struct {
+		image   string
+		policy  v1.PullPolicy
+		imageID string
+		e       string
+	}{
+		"always": {
+			image:   "nginx:1.27",
+			policy:  v1.PullAlways,
+			imageID: "docker.io/library/nginx@sha256:abc",
+			e:       "Always (pulled)",
+		},
+		"cached": {
+			image:   "nginx:1.27",
+			policy:  v1.PullIfNotPresent,
+			imageID: "docker.io/library/nginx@sha256:abc",
+			e:       "IfNotPresent (cached)",
+		},
+		"pending": {
+			image:  "nginx:1.27",
+			policy: v1.PullIfNotPresent,
+			e:      "IfNotPresent (pending)",
+		},
+		"never": {
+			image:   "nginx:1.27",
+			policy:  v1.PullNever,
+			imageID: "sha256:abc",
+			e:       "Never (cached)",
+		},
+		"default-latest": {
+			image:   "nginx",
+			imageID: "sha256:abc",
+			e:       "Always (pulled)",
+		},
+		"default-tagged": {
+			image:   "nginx:1.27",
+			imageID: "sha256:abc",
+			e:       "IfNotPresent (cached)",
+		},
+		"default-digest": {
+			image: "nginx@sha256:abc",
+			e:     "IfNotPresent (pending)",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, render.FormatImageStatus(u.image, u.policy, u.imageID))
+		})
+	}
+}
@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/duration"
+)
+
+// IncidentFormat represents the incident reference time display format.
+const IncidentFormat = "2006-01-02 15:04:05"
+
+var incidentLayouts = []string{
+	time.RFC3339,
+	IncidentFormat,
+	"2006-01-02 15:04",
+}
+
+// incident tracks an optional session reference time ages are rendered against.
+var incident struct {
+	at time.Time
+	mx sync.RWMutex
+}
+
+// SetIncidentTime turns on incident mode. Ages are rendered relative to the given time.
+func SetIncidentTime(t time.Time) {
+	incident.mx.Lock()
+	defer incident.mx.Unlock()
+
+	incident.at = t
+}
+
+// ClearIncidentTime turns incident mode off.
+func ClearIncidentTime() {
+	SetIncidentTime(time.Time{})
+}
+
+// IncidentTime returns the incident reference time if incident mode is on.
+func IncidentTime() (time.Time, bool) {
+	incident.mx.RLock()
+	defer incident.mx.RUnlock()
+
+	return incident.at, !incident.at.IsZero()
+}
+
+// ParseIncidentTime parses a reference time as a timestamp, a time of day or a duration ago.
+func ParseIncidentTime(s string, now time.Time) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	if d, err := time.ParseDuration(s); err == nil {
+		return now.Add(-d.Abs()), nil
+	}
+	for _, l := range incidentLayouts {
+		if t, err := time.ParseInLocation(l, s, now.Location()); err == nil {
+			return t, nil
+		}
+	}
+	for _, l := range []string{"15:04:05", "15:04"} {
+		if t, err := time.ParseInLocation(l, s, now.Location()); err == nil {
+			y, m, d := now.Date()
+			return time.Date(y, m, d, t.Hour(), t.Minute(), t.Second(), 0, now.Location()), nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("invalid incident time %q (expecting a timestamp, HH:MM or a duration ago ie 30m)", s)
+}
+
+// toAge returns a human duration since a given time or relative to the incident time when set.
+// Times before the incident are prefixed with a minus sign.
+func toAge(t time.Time) string {
+	ref, ok := IncidentTime()
+	if !ok {
+		return duration.HumanDuration(time.Since(t))
+	}
+	d := t.Sub(ref)
+	if d < 0 {
+		return "-" + duration.HumanDuration(-d)
+	}
+
+	return "+" + duration.HumanDuration(d)
+}
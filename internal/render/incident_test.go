@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestParseIncidentTime(t *testing.T) {
+	now := time.Date(2024, 3, 4, 10, 30, 0, 0, time.UTC)
+	uu := map[string]struct {
+		s   string
+		e   time.Time
+		err string
+	}{
+		"duration": {
+			s: "30m",
+			e: time.Date(2024, 3, 4, 10, 0, 0, 0, time.UTC),
+		},
+		"rfc3339": {
+			s: "2024-03-01T08:00:00Z",
+			e: time.Date(2024, 3, 1, 8, 0, 0, 0, time.UTC),
+		},
+		"timestamp": {
+			s: "2024-03-01 08:00:05",
+			e: time.Date(2024, 3, 1, 8, 0, 5, 0, time.UTC),
+		},
+		"time-of-day": {
+			s: " 09:15 ",
+			e: time.Date(2024, 3, 4, 9, 15, 0, 0, time.UTC),
+		},
+		"toast": {
+			s:   "yesterday",
+			err: `invalid incident time "yesterday" (expecting a timestamp, HH:MM or a duration ago ie 30m)`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			at, err := ParseIncidentTime(u.s, now)
+			if u.err != "" {
+				assert.EqualError(t, err, u.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, u.e, at)
+		})
+	}
+}
+
+func TestToAgeIncident(t *testing.T) {
+	ref := time.Now().Add(-time.Hour)
+	SetIncidentTime(ref)
+	defer ClearIncidentTime()
+
+	uu := map[string]struct {
+		t time.Time
+		e string
+	}{
+		"after": {
+			t: ref.Add(2 * time.Minute),
+			e: "+2m",
+		},
+		"before": {
+			t: ref.Add(-5 * time.Minute),
+			e: "-5m",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, ToAge(metav1.Time{Time: u.t}))
+		})
+	}
+}
@@ -20,7 +20,7 @@ import (
 var defaultJOBHeader = model1.Header{
 	model1.HeaderColumn{Name: "NAMESPACE"},
 	model1.HeaderColumn{Name: "NAME"},
-	model1.HeaderColumn{Name: "VS", Attrs: model1.Attrs{VS: true}},
+	model1.HeaderColumn{Name: "VS", Attrs: model1.Attrs{VS: true, Decorator: VulDecorator}},
 	model1.HeaderColumn{Name: "COMPLETIONS"},
 	model1.HeaderColumn{Name: "DURATION"},
 	model1.HeaderColumn{Name: "SELECTOR", Attrs: model1.Attrs{Wide: true}},
@@ -75,7 +75,7 @@ func (j Job) defaultRow(raw *unstructured.Unstructured, r *model1.Row) error {
 	r.Fields = model1.Fields{
 		job.Namespace,
 		job.Name,
-		computeVulScore(job.Namespace, job.Labels, &job.Spec.Template.Spec),
+		computeVulScore(job.Namespace, job.Labels, job.Annotations, &job.Spec.Template.Spec),
 		ready,
 		toDuration(&job.Status),
 		jobSelector(&job.Spec),
@@ -28,6 +28,9 @@ import (
 const (
 	labelNodeRolePrefix = "node-role.kubernetes.io/"
 	labelNodeRoleSuffix = "kubernetes.io/role"
+
+	// maxNodeLabels caps the labels listed per node as clouds add plenty.
+	maxNodeLabels = 10
 )
 
 var defaultNOHeader = model1.Header{
@@ -49,6 +52,8 @@ var defaultNOHeader = model1.Header{
 	// model1.HeaderColumn{Name: "MEM/A", Attrs: model1.Attrs{Align: tview.AlignRight, MX: true}},
 	model1.HeaderColumn{Name: "%MEM", Attrs: model1.Attrs{Align: tview.AlignRight, MX: true}},
 	model1.HeaderColumn{Name: "GPU/A", Attrs: model1.Attrs{Align: tview.AlignRight, MX: true}},
+	model1.HeaderColumn{Name: "CPU/RSV", Attrs: model1.Attrs{Align: tview.AlignRight, Wide: true}},
+	model1.HeaderColumn{Name: "MEM/RSV", Attrs: model1.Attrs{Align: tview.AlignRight, Wide: true}},
 	// model1.HeaderColumn{Name: "GPU/C", Attrs: model1.Attrs{Align: tview.AlignRight, MX: true}},
 	// model1.HeaderColumn{Name: "SH-GPU/A", Attrs: model1.Attrs{Align: tview.AlignRight, MX: true}},
 	// model1.HeaderColumn{Name: "SH-GPU/C", Attrs: model1.Attrs{Align: tview.AlignRight, MX: true}},
@@ -101,13 +106,11 @@ func (n Node) defaultRow(nwm *NodeWithMetrics, r *model1.Row) error {
 	iIP, eIP = missing(iIP), missing(eIP)
 
 	c, a := gatherNodeMX(&no, nwm.MX)
+	rsv := nodeReserved(&no)
 
 	statuses := make(sort.StringSlice, 10)
 	status(no.Status.Conditions, no.Spec.Unschedulable, statuses)
 	sort.Sort(statuses)
-	roles := make(sort.StringSlice, 10)
-	nodeRoles(&no, roles)
-	sort.Sort(roles)
 
 	podCount := strconv.Itoa(nwm.PodCount)
 	if pc := nwm.PodCount; pc == -1 {
@@ -117,7 +120,7 @@ func (n Node) defaultRow(nwm *NodeWithMetrics, r *model1.Row) error {
 	r.Fields = model1.Fields{
 		no.Name,
 		join(statuses, ","),
-		join(roles, ","),
+		NodeRoles(no.Labels),
 		no.Status.NodeInfo.Architecture,
 		strconv.Itoa(len(no.Spec.Taints)),
 		no.Status.NodeInfo.KubeletVersion,
@@ -128,15 +131,17 @@ func (n Node) defaultRow(nwm *NodeWithMetrics, r *model1.Row) error {
 		podCount,
 		// toMc(c.cpu),
 		// toMc(a.cpu),
-		decimalPct(c.cpu, a.cpu),
+		cpuPct(c.cpu, a.cpu),
 		// toMi(c.mem),
 		// toMi(a.mem),
 		memPct(c.mem, a.mem),
 		toMu(a.gpu),
+		signedDecimal(rsv.cpu),
+		signedBytes(rsv.mem),
 		// toMu(c.gpu),
 		// toMu(a.gpuShared),
 		// toMu(c.gpuShared),
-		mapToStr(no.Labels),
+		mapToStrN(no.Labels, maxNodeLabels),
 		AsStatus(n.diagnose(statuses)),
 		ToAge(no.GetCreationTimestamp()),
 	}
@@ -241,6 +246,14 @@ func gatherNodeMX(no *v1.Node, mx *mv1beta1.NodeMetrics) (c, a metric) {
 	return
 }
 
+// nodeReserved returns the allocatable delta against capacity ie resources held back by the kubelet.
+func nodeReserved(no *v1.Node) metric {
+	return metric{
+		cpu: no.Status.Allocatable.Cpu().MilliValue() - no.Status.Capacity.Cpu().MilliValue(),
+		mem: no.Status.Allocatable.Memory().Value() - no.Status.Capacity.Memory().Value(),
+	}
+}
+
 func extractNodeGPU(rl v1.ResourceList) (main, shared *resource.Quantity) {
 	mm := make(map[string]*resource.Quantity, len(config.KnownGPUVendors))
 	for _, v := range config.KnownGPUVendors {
@@ -259,27 +272,32 @@ func extractNodeGPU(rl v1.ResourceList) (main, shared *resource.Quantity) {
 	return
 }
 
-func nodeRoles(node *v1.Node, res []string) {
-	index := 0
-	for k, v := range node.Labels {
+// NodeRoles returns a node sorted roles from its labels or MissingValue if none.
+// Roles are gathered from both node-role.kubernetes.io/<role> and kubernetes.io/role labels.
+func NodeRoles(lbls map[string]string) string {
+	rr := make([]string, 0, len(lbls))
+	seen := make(map[string]struct{}, len(lbls))
+	add := func(r string) {
+		if _, ok := seen[r]; ok || r == "" {
+			return
+		}
+		seen[r] = struct{}{}
+		rr = append(rr, r)
+	}
+	for k, v := range lbls {
 		switch {
 		case strings.HasPrefix(k, labelNodeRolePrefix):
-			if role := strings.TrimPrefix(k, labelNodeRolePrefix); role != "" {
-				res[index] = role
-				index++
-			}
-		case strings.HasSuffix(k, labelNodeRoleSuffix) && v != "":
-			res[index] = v
-			index++
-		}
-		if index >= len(res) {
-			break
+			add(strings.TrimPrefix(k, labelNodeRolePrefix))
+		case strings.HasSuffix(k, labelNodeRoleSuffix):
+			add(v)
 		}
 	}
-
-	if blank(res) {
-		res[index] = MissingValue
+	if len(rr) == 0 {
+		return MissingValue
 	}
+	sort.Strings(rr)
+
+	return strings.Join(rr, ",")
 }
 
 func getIPs(addrs []v1.NodeAddress) (iIP, eIP string) {
@@ -198,3 +198,46 @@ func makeQ(t *testing.T, v string) *resource.Quantity {
 
 	return &q
 }
+
+func Test_nodeReserved(t *testing.T) {
+	uu := map[string]struct {
+		node   v1.Node
+		e      metric
+		ec, em string
+	}{
+		"empty": {
+			ec: "0",
+			em: "0",
+		},
+		"reserved": {
+			node: v1.Node{
+				Status: v1.NodeStatus{
+					Capacity: v1.ResourceList{
+						v1.ResourceCPU:    resource.MustParse("4"),
+						v1.ResourceMemory: resource.MustParse("8Gi"),
+					},
+					Allocatable: v1.ResourceList{
+						v1.ResourceCPU:    resource.MustParse("3500m"),
+						v1.ResourceMemory: resource.MustParse("7Gi"),
+					},
+				},
+			},
+			e: metric{
+				cpu: -500,
+				mem: -1073741824,
+			},
+			ec: "-.5",
+			em: "-1G",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			rsv := nodeReserved(&u.node)
+			assert.Equal(t, u.e, rsv)
+			assert.Equal(t, u.ec, signedDecimal(rsv.cpu))
+			assert.Equal(t, u.em, signedBytes(rsv.mem))
+		})
+	}
+}
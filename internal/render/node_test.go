@@ -30,6 +30,54 @@ func TestNodeRender(t *testing.T) {
 	assert.Equal(t, e, r.Fields[:19])
 }
 
+func TestNodeRoles(t *testing.T) {
+	uu := map[string]struct {
+		ll map[string]string
+		e  string
+	}{
+		"empty": {
+			e: render.MissingValue,
+		},
+		"no-roles": {
+			ll: map[string]string{"kubernetes.io/hostname": "n1"},
+			e:  render.MissingValue,
+		},
+		"prefix": {
+			ll: map[string]string{
+				"node-role.kubernetes.io/worker":        "",
+				"node-role.kubernetes.io/control-plane": "",
+			},
+			e: "control-plane,worker",
+		},
+		"legacy": {
+			ll: map[string]string{"kubernetes.io/role": "master"},
+			e:  "master",
+		},
+		"mixed": {
+			ll: map[string]string{
+				"node-role.kubernetes.io/worker": "",
+				"kubernetes.io/role":             "worker",
+				"node-role.kubernetes.io/infra":  "",
+			},
+			e: "infra,worker",
+		},
+		"blank": {
+			ll: map[string]string{
+				"node-role.kubernetes.io/": "",
+				"kubernetes.io/role":       "",
+			},
+			e: render.MissingValue,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, render.NodeRoles(u.ll))
+		})
+	}
+}
+
 func BenchmarkNodeRender(b *testing.B) {
 	var (
 		no  render.Node
@@ -31,6 +31,9 @@ const (
 	// cannot be confirmed as kubelet is unresponsive on the node it is (was) running.
 	NodeUnreachablePodReason = "NodeLost" // k8s.io/kubernetes/pkg/util/node.NodeUnreachablePodReason
 	vulIdx                   = 2
+
+	// maxPodLabelsWidth bounds the pod labels column so charts labels do not swamp wide mode.
+	maxPodLabelsWidth = 120
 )
 
 const (
@@ -51,10 +54,52 @@ const (
 	PhaseEvicted                = "Evicted"
 )
 
+// FormatScheduling returns a pod node, noting non default schedulers ie n1 [my-scheduler].
+func FormatScheduling(nodeName, schedulerName string) string {
+	n := check(nodeName, UnscheduledValue)
+	if schedulerName == "" || schedulerName == v1.DefaultSchedulerName {
+		return n
+	}
+
+	return n + " [" + schedulerName + "]"
+}
+
+// FormatQoS returns an abbreviated pod QoS class ie Gtd and its severity bucket.
+// Best effort pods rank highest as they are evicted first.
+func FormatQoS(qos v1.PodQOSClass) (string, Bucket) {
+	switch qos {
+	case v1.PodQOSGuaranteed:
+		return "Gtd", BucketLow
+	case v1.PodQOSBurstable:
+		return "Bst", BucketMed
+	case v1.PodQOSBestEffort:
+		return "BE", BucketHigh
+	default:
+		return NAValue, BucketUnknown
+	}
+}
+
+// PhaseBucket returns a severity bucket for a pod phase or computed status ie CrashLoopBackOff.
+// Statuses not accounted for ie Init:0/1 yield BucketUnknown.
+func PhaseBucket(phase v1.PodPhase) Bucket {
+	switch phase {
+	case PhaseRunning, PhaseCompleted, v1.PodSucceeded:
+		return BucketLow
+	case PhasePending, PhaseContainerCreating, PhasePodInitializing, PhaseInitialized, PhaseTerminating:
+		return BucketMed
+	case PhaseNotReady, PhaseUnknown, PhaseContainerStatusUnknown:
+		return BucketHigh
+	case v1.PodFailed, PhaseCrashLoop, PhaseError, PhaseImagePullBackOff, PhaseOOMKilled, PhaseEvicted, NodeUnreachablePodReason:
+		return BucketCritical
+	default:
+		return BucketUnknown
+	}
+}
+
 var defaultPodHeader = model1.Header{
 	model1.HeaderColumn{Name: "NAMESPACE"},
 	model1.HeaderColumn{Name: "NAME"},
-	model1.HeaderColumn{Name: "VS", Attrs: model1.Attrs{VS: true}},
+	model1.HeaderColumn{Name: "VS", Attrs: model1.Attrs{VS: true, Decorator: VulDecorator}},
 	model1.HeaderColumn{Name: "PF"},
 	model1.HeaderColumn{Name: "READY"},
 	model1.HeaderColumn{Name: "STATUS"},
@@ -74,6 +119,7 @@ var defaultPodHeader = model1.Header{
 	model1.HeaderColumn{Name: "SERVICE-ACCOUNT", Attrs: model1.Attrs{Wide: true}},
 	model1.HeaderColumn{Name: "NOMINATED NODE", Attrs: model1.Attrs{Wide: true}},
 	model1.HeaderColumn{Name: "READINESS GATES", Attrs: model1.Attrs{Wide: true}},
+	model1.HeaderColumn{Name: "HASH", Attrs: model1.Attrs{Wide: true, Decorator: HashDecorator}},
 	model1.HeaderColumn{Name: "QOS", Attrs: model1.Attrs{Wide: true}},
 	model1.HeaderColumn{Name: "LABELS", Attrs: model1.Attrs{Wide: true}},
 	model1.HeaderColumn{Name: "VALID", Attrs: model1.Attrs{Wide: true}},
@@ -181,7 +227,7 @@ func (p *Pod) defaultRow(pwm *PodWithMetrics, row *model1.Row) error {
 	row.Fields = model1.Fields{
 		ns,
 		n,
-		computeVulScore(ns, pwm.Raw.GetLabels(), spec),
+		computeVulScore(ns, pwm.Raw.GetLabels(), pwm.Raw.GetAnnotations(), spec),
 		"●",
 		strconv.Itoa(cReady) + "/" + strconv.Itoa(allCounts),
 		phase,
@@ -189,8 +235,8 @@ func (p *Pod) defaultRow(pwm *PodWithMetrics, row *model1.Row) error {
 		ToAge(lastRestart),
 		// toMc(c.cpu),
 		// toMc(r.cpu) + ":" + toMc(r.lcpu),
-		decimalPct(c.cpu, r.cpu),
-		decimalPct(c.cpu, r.lcpu),
+		cpuPct(c.cpu, r.cpu),
+		cpuPctCollapsed(c.cpu, r.lcpu),
 		// toMi(c.mem),
 		// toMi(r.mem) + ":" + toMi(r.lmem),
 		memPct(c.mem, r.mem),
@@ -201,8 +247,9 @@ func (p *Pod) defaultRow(pwm *PodWithMetrics, row *model1.Row) error {
 		na(spec.ServiceAccountName),
 		asNominated(st.NominatedNodeName),
 		asReadinessGate(spec, &st),
+		toTemplateHash(pwm.Raw.GetLabels()),
 		p.mapQOS(st.QOSClass),
-		mapToStr(pwm.Raw.GetLabels()),
+		boundedLabels(pwm.Raw.GetLabels(), maxPodLabelsWidth),
 		AsStatus(p.diagnose(phase, cReady, allCounts, ready, rgr, rgt)),
 		ToAge(pwm.Raw.GetCreationTimestamp()),
 	}
@@ -0,0 +1,213 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// Unconstrained tracks pods without scheduling constraints.
+	Unconstrained = "unconstrained"
+
+	// maxSchedExprs tracks the max number of expressions shown when abbreviated.
+	maxSchedExprs = 2
+
+	// maxSchedValues tracks the max number of values shown when abbreviated.
+	maxSchedValues = 3
+)
+
+var topologyNames = map[string]string{
+	v1.LabelHostname:                "node",
+	v1.LabelTopologyZone:            "zone",
+	v1.LabelTopologyRegion:          "region",
+	v1.LabelFailureDomainBetaZone:   "zone",
+	v1.LabelFailureDomainBetaRegion: "region",
+}
+
+// PodScheduling summarizes a pod scheduling constraints in human readable sentences.
+// Complex expressions are abbreviated unless expand is set.
+func PodScheduling(spec *v1.PodSpec, expand bool) []string {
+	ss := make([]string, 0, 5)
+	if len(spec.NodeSelector) > 0 {
+		kk := make([]string, 0, len(spec.NodeSelector))
+		for k, v := range spec.NodeSelector {
+			kk = append(kk, k+"="+v)
+		}
+		sort.Strings(kk)
+		ss = append(ss, "requires node with "+abbrev(kk, expand, maxSchedExprs))
+	}
+	if a := spec.Affinity; a != nil {
+		ss = append(ss, nodeAffinity(a.NodeAffinity, expand)...)
+		ss = append(ss, podAffinity(a.PodAffinity, "co-located with", expand)...)
+		ss = append(ss, podAntiAffinity(a.PodAntiAffinity, expand)...)
+	}
+	for _, c := range spec.TopologySpreadConstraints {
+		verb := "requires"
+		if c.WhenUnsatisfiable == v1.ScheduleAnyway {
+			verb = "prefers"
+		}
+		s := fmt.Sprintf("%s %s spread", verb, topologyName(c.TopologyKey))
+		if sel := selector(c.LabelSelector, expand); sel != "" {
+			s += " of " + sel
+		}
+		ss = append(ss, s+fmt.Sprintf(" (max skew %d)", c.MaxSkew))
+	}
+	if len(ss) == 0 {
+		return []string{Unconstrained}
+	}
+
+	return ss
+}
+
+func nodeAffinity(a *v1.NodeAffinity, expand bool) []string {
+	if a == nil {
+		return nil
+	}
+	ss := make([]string, 0, 2)
+	if r := a.RequiredDuringSchedulingIgnoredDuringExecution; r != nil {
+		tt := make([]string, 0, len(r.NodeSelectorTerms))
+		for _, t := range r.NodeSelectorTerms {
+			tt = append(tt, nodeSelectorTerm(t, expand))
+		}
+		ss = append(ss, "requires node with "+strings.Join(tt, " or "))
+	}
+	for _, p := range a.PreferredDuringSchedulingIgnoredDuringExecution {
+		ss = append(ss, fmt.Sprintf("prefers node with %s (weight %d)", nodeSelectorTerm(p.Preference, expand), p.Weight))
+	}
+
+	return ss
+}
+
+func podAffinity(a *v1.PodAffinity, rel string, expand bool) []string {
+	if a == nil {
+		return nil
+	}
+
+	return affinityTerms(a.RequiredDuringSchedulingIgnoredDuringExecution, a.PreferredDuringSchedulingIgnoredDuringExecution, rel, expand)
+}
+
+func podAntiAffinity(a *v1.PodAntiAffinity, expand bool) []string {
+	if a == nil {
+		return nil
+	}
+
+	return affinityTerms(a.RequiredDuringSchedulingIgnoredDuringExecution, a.PreferredDuringSchedulingIgnoredDuringExecution, "not co-located with", expand)
+}
+
+func affinityTerms(req []v1.PodAffinityTerm, pref []v1.WeightedPodAffinityTerm, rel string, expand bool) []string {
+	ss := make([]string, 0, len(req)+len(pref))
+	for _, t := range req {
+		ss = append(ss, "requires "+affinityTerm(t, rel, expand))
+	}
+	for _, p := range pref {
+		ss = append(ss, fmt.Sprintf("prefers %s (weight %d)", affinityTerm(p.PodAffinityTerm, rel, expand), p.Weight))
+	}
+
+	return ss
+}
+
+func affinityTerm(t v1.PodAffinityTerm, rel string, expand bool) string {
+	sel := selector(t.LabelSelector, expand)
+	if sel == "" {
+		sel = "any pod"
+	}
+	s := fmt.Sprintf("%s %s per %s", rel, sel, topologyName(t.TopologyKey))
+	if len(t.Namespaces) > 0 {
+		s += " in " + abbrev(t.Namespaces, expand, maxSchedValues)
+	}
+
+	return s
+}
+
+func nodeSelectorTerm(t v1.NodeSelectorTerm, expand bool) string {
+	ee := make([]string, 0, len(t.MatchExpressions)+len(t.MatchFields))
+	for _, r := range t.MatchExpressions {
+		ee = append(ee, nodeRequirement(r, expand))
+	}
+	for _, r := range t.MatchFields {
+		ee = append(ee, nodeRequirement(r, expand))
+	}
+
+	return abbrev(ee, expand, maxSchedExprs)
+}
+
+func nodeRequirement(r v1.NodeSelectorRequirement, expand bool) string {
+	switch r.Operator {
+	case v1.NodeSelectorOpExists:
+		return r.Key
+	case v1.NodeSelectorOpDoesNotExist:
+		return "!" + r.Key
+	case v1.NodeSelectorOpGt:
+		return fmt.Sprintf("%s > %s", r.Key, strings.Join(r.Values, ","))
+	case v1.NodeSelectorOpLt:
+		return fmt.Sprintf("%s < %s", r.Key, strings.Join(r.Values, ","))
+	case v1.NodeSelectorOpNotIn:
+		return setRequirement(r.Key, "notin", r.Values, expand)
+	default:
+		return setRequirement(r.Key, "in", r.Values, expand)
+	}
+}
+
+func setRequirement(k, op string, vv []string, expand bool) string {
+	if len(vv) == 1 {
+		if op == "in" {
+			return k + "=" + vv[0]
+		}
+		return k + "!=" + vv[0]
+	}
+
+	return fmt.Sprintf("%s %s (%s)", k, op, abbrev(vv, expand, maxSchedValues))
+}
+
+func selector(sel *metav1.LabelSelector, expand bool) string {
+	if sel == nil {
+		return ""
+	}
+	ee := make([]string, 0, len(sel.MatchLabels)+len(sel.MatchExpressions))
+	kk := make([]string, 0, len(sel.MatchLabels))
+	for k := range sel.MatchLabels {
+		kk = append(kk, k)
+	}
+	sort.Strings(kk)
+	for _, k := range kk {
+		ee = append(ee, k+"="+sel.MatchLabels[k])
+	}
+	for _, r := range sel.MatchExpressions {
+		switch r.Operator {
+		case metav1.LabelSelectorOpExists:
+			ee = append(ee, r.Key)
+		case metav1.LabelSelectorOpDoesNotExist:
+			ee = append(ee, "!"+r.Key)
+		case metav1.LabelSelectorOpNotIn:
+			ee = append(ee, setRequirement(r.Key, "notin", r.Values, expand))
+		default:
+			ee = append(ee, setRequirement(r.Key, "in", r.Values, expand))
+		}
+	}
+
+	return abbrev(ee, expand, maxSchedExprs)
+}
+
+func topologyName(k string) string {
+	if n, ok := topologyNames[k]; ok {
+		return n
+	}
+
+	return k
+}
+
+// abbrev joins items, eliding the ones past n unless expand is set.
+func abbrev(ss []string, expand bool, n int) string {
+	if expand || len(ss) <= n {
+		return strings.Join(ss, ",")
+	}
+
+	return fmt.Sprintf("%s,%s(+%d)", strings.Join(ss[:n], ","), Ellipsis(), len(ss)-n)
+}
@@ -0,0 +1,109 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestPodScheduling(t *testing.T) {
+	uu := map[string]struct {
+		spec   v1.PodSpec
+		expand bool
+		e      []string
+	}{
+		"unconstrained": {
+			e: []string{render.Unconstrained},
+		},
+		"node-selector": {
+			spec: v1.PodSpec{NodeSelector: map[string]string{"disktype": "ssd"}},
+			e:    []string{"requires node with disktype=ssd"},
+		},
+		"spread-anti-affinity": {
+			spec: v1.PodSpec{
+				Affinity: &v1.Affinity{
+					PodAntiAffinity: &v1.PodAntiAffinity{
+						RequiredDuringSchedulingIgnoredDuringExecution: []v1.PodAffinityTerm{
+							{
+								LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}},
+								TopologyKey:   v1.LabelHostname,
+							},
+						},
+					},
+				},
+				TopologySpreadConstraints: []v1.TopologySpreadConstraint{
+					{
+						MaxSkew:           1,
+						TopologyKey:       v1.LabelTopologyZone,
+						WhenUnsatisfiable: v1.ScheduleAnyway,
+					},
+				},
+			},
+			e: []string{
+				"requires not co-located with app=db per node",
+				"prefers zone spread (max skew 1)",
+			},
+		},
+		"node-affinity": {
+			spec: v1.PodSpec{Affinity: nodeAff()},
+			e: []string{
+				"requires node with zone in (a,b,c,…(+1)),gpu,…(+1) or !spot",
+				"prefers node with disktype=ssd (weight 10)",
+			},
+		},
+		"node-affinity-expanded": {
+			spec:   v1.PodSpec{Affinity: nodeAff()},
+			expand: true,
+			e: []string{
+				"requires node with zone in (a,b,c,d),gpu,cpu > 4 or !spot",
+				"prefers node with disktype=ssd (weight 10)",
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, render.PodScheduling(&u.spec, u.expand))
+		})
+	}
+}
+
+func nodeAff() *v1.Affinity {
+	return &v1.Affinity{
+		NodeAffinity: &v1.NodeAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: &v1.NodeSelector{
+				NodeSelectorTerms: []v1.NodeSelectorTerm{
+					{
+						MatchExpressions: []v1.NodeSelectorRequirement{
+							{Key: "zone", Operator: v1.NodeSelectorOpIn, Values: []string{"a", "b", "c", "d"}},
+							{Key: "gpu", Operator: v1.NodeSelectorOpExists},
+							{Key: "cpu", Operator: v1.NodeSelectorOpGt, Values: []string{"4"}},
+						},
+					},
+					{
+						MatchExpressions: []v1.NodeSelectorRequirement{
+							{Key: "spot", Operator: v1.NodeSelectorOpDoesNotExist},
+						},
+					},
+				},
+			},
+			PreferredDuringSchedulingIgnoredDuringExecution: []v1.PreferredSchedulingTerm{
+				{
+					Weight: 10,
+					Preference: v1.NodeSelectorTerm{
+						MatchExpressions: []v1.NodeSelectorRequirement{
+							{Key: "disktype", Operator: v1.NodeSelectorOpIn, Values: []string{"ssd"}},
+						},
+					},
+				},
+			},
+		},
+	}
+}
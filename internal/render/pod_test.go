@@ -544,6 +544,94 @@ func TestCheckPodStatus(t *testing.T) {
 	}
 }
 
+func TestFormatScheduling(t *testing.T) {
+	uu := map[string]struct {
+		node, scheduler string
+		e               string
+	}{
+		"default": {
+			node:      "n1",
+			scheduler: v1.DefaultSchedulerName,
+			e:         "n1",
+		},
+		"blank-scheduler": {
+			node: "n1",
+			e:    "n1",
+		},
+		"custom": {
+			node:      "n1",
+			scheduler: "volcano",
+			e:         "n1 [volcano]",
+		},
+		"unscheduled": {
+			e: render.UnscheduledValue,
+		},
+		"unscheduled-custom": {
+			scheduler: "volcano",
+			e:         "<unscheduled> [volcano]",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, render.FormatScheduling(u.node, u.scheduler))
+		})
+	}
+}
+
+func TestFormatQoS(t *testing.T) {
+	uu := map[string]struct {
+		qos v1.PodQOSClass
+		e   string
+		b   render.Bucket
+	}{
+		"guaranteed":  {qos: v1.PodQOSGuaranteed, e: "Gtd", b: render.BucketLow},
+		"burstable":   {qos: v1.PodQOSBurstable, e: "Bst", b: render.BucketMed},
+		"best-effort": {qos: v1.PodQOSBestEffort, e: "BE", b: render.BucketHigh},
+		"empty":       {e: render.NAValue, b: render.BucketUnknown},
+		"unknown":     {qos: "Fancy", e: render.NAValue, b: render.BucketUnknown},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			s, b := render.FormatQoS(u.qos)
+			assert.Equal(t, u.e, s)
+			assert.Equal(t, u.b, b)
+		})
+	}
+}
+
+func TestPhaseBucket(t *testing.T) {
+	uu := map[string]struct {
+		phase v1.PodPhase
+		e     render.Bucket
+	}{
+		"running":     {phase: v1.PodRunning, e: render.BucketLow},
+		"succeeded":   {phase: v1.PodSucceeded, e: render.BucketLow},
+		"completed":   {phase: render.PhaseCompleted, e: render.BucketLow},
+		"pending":     {phase: v1.PodPending, e: render.BucketMed},
+		"creating":    {phase: render.PhaseContainerCreating, e: render.BucketMed},
+		"terminating": {phase: render.PhaseTerminating, e: render.BucketMed},
+		"unknown":     {phase: v1.PodUnknown, e: render.BucketHigh},
+		"not-ready":   {phase: render.PhaseNotReady, e: render.BucketHigh},
+		"failed":      {phase: v1.PodFailed, e: render.BucketCritical},
+		"crashloop":   {phase: render.PhaseCrashLoop, e: render.BucketCritical},
+		"oom":         {phase: render.PhaseOOMKilled, e: render.BucketCritical},
+		"evicted":     {phase: render.PhaseEvicted, e: render.BucketCritical},
+		"node-lost":   {phase: render.NodeUnreachablePodReason, e: render.BucketCritical},
+		"init":        {phase: "Init:0/1", e: render.BucketUnknown},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, render.PhaseBucket(u.phase))
+		})
+	}
+}
+
 func TestCheckPhase(t *testing.T) {
 	always := v1.ContainerRestartPolicyAlways
 	uu := map[string]struct {
@@ -6,6 +6,7 @@ package render
 import (
 	"fmt"
 	"path"
+	"sort"
 	"strings"
 
 	"github.com/derailed/k9s/internal/client"
@@ -114,7 +115,7 @@ func (p PersistentVolume) defaultRow(raw *unstructured.Unstructured, r *model1.R
 	r.Fields = model1.Fields{
 		pv.Name,
 		size.String(),
-		accessMode(pv.Spec.AccessModes),
+		FormatAccessModes(pv.Spec.AccessModes),
 		string(pv.Spec.PersistentVolumeReclaimPolicy),
 		string(phase),
 		claim,
@@ -148,23 +149,36 @@ func (PersistentVolume) volumeMode(m *v1.PersistentVolumeMode) string {
 // ----------------------------------------------------------------------------
 // Helpers...
 
-func accessMode(aa []v1.PersistentVolumeAccessMode) string {
+// ShortAccessMode returns a volume access mode short code ie RWO.
+// Unknown modes render as is.
+func ShortAccessMode(m v1.PersistentVolumeAccessMode) string {
+	switch m {
+	case v1.ReadWriteOnce:
+		return "RWO"
+	case v1.ReadOnlyMany:
+		return "ROX"
+	case v1.ReadWriteMany:
+		return "RWX"
+	case v1.ReadWriteOncePod:
+		return "RWOP"
+	default:
+		return string(m)
+	}
+}
+
+// FormatAccessModes returns sorted volume access modes short codes ie ROX,RWO or NAValue if none.
+func FormatAccessModes(aa []v1.PersistentVolumeAccessMode) string {
 	dd := accessDedup(aa)
-	s := make([]string, 0, len(dd))
+	if len(dd) == 0 {
+		return NAValue
+	}
+	ss := make([]string, 0, len(dd))
 	for _, am := range dd {
-		switch am {
-		case v1.ReadWriteOnce:
-			s = append(s, "RWO")
-		case v1.ReadOnlyMany:
-			s = append(s, "ROX")
-		case v1.ReadWriteMany:
-			s = append(s, "RWX")
-		case v1.ReadWriteOncePod:
-			s = append(s, "RWOP")
-		}
+		ss = append(ss, ShortAccessMode(am))
 	}
+	sort.Strings(ss)
 
-	return strings.Join(s, ",")
+	return join(ss, ",")
 }
 
 func accessContains(cc []v1.PersistentVolumeAccessMode, a v1.PersistentVolumeAccessMode) bool {
@@ -10,6 +10,7 @@ import (
 	"github.com/derailed/k9s/internal/render"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
 )
 
 func TestPersistentVolumeRender(t *testing.T) {
@@ -29,3 +30,37 @@ func TestTerminatingPersistentVolumeRender(t *testing.T) {
 	assert.Equal(t, "-/pvc-a4d86f51-916c-476b-83af-b551c91a8ac0", r.ID)
 	assert.Equal(t, model1.Fields{"pvc-a4d86f51-916c-476b-83af-b551c91a8ac0", "1Gi", "RWO", "Delete", "Terminating", "default/www-nginx-sts-2", "standard"}, r.Fields[:7])
 }
+
+func TestFormatAccessModes(t *testing.T) {
+	uu := map[string]struct {
+		aa []v1.PersistentVolumeAccessMode
+		e  string
+	}{
+		"empty": {
+			e: render.NAValue,
+		},
+		"single": {
+			aa: []v1.PersistentVolumeAccessMode{v1.ReadWriteOncePod},
+			e:  "RWOP",
+		},
+		"sorted": {
+			aa: []v1.PersistentVolumeAccessMode{v1.ReadWriteMany, v1.ReadWriteOnce, v1.ReadOnlyMany},
+			e:  "ROX,RWO,RWX",
+		},
+		"dups": {
+			aa: []v1.PersistentVolumeAccessMode{v1.ReadWriteOnce, v1.ReadWriteOnce},
+			e:  "RWO",
+		},
+		"unknown": {
+			aa: []v1.PersistentVolumeAccessMode{"ReadWriteSometimes"},
+			e:  "ReadWriteSometimes",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, render.FormatAccessModes(u.aa))
+		})
+	}
+}
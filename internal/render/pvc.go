@@ -74,15 +74,14 @@ func (p PersistentVolumeClaim) defaultRow(raw *unstructured.Unstructured, r *mod
 	storage := pvc.Spec.Resources.Requests[v1.ResourceStorage]
 	var capacity, accessModes string
 	if pvc.Spec.VolumeName != "" {
-		accessModes = accessMode(pvc.Status.AccessModes)
+		accessModes = FormatAccessModes(pvc.Status.AccessModes)
 		storage = pvc.Status.Capacity[v1.ResourceStorage]
 		capacity = storage.String()
 	}
+	// An unset class defers to the default storage class whereas a blank one opts out.
 	class, found := pvc.Annotations[v1.BetaStorageClassAnnotation]
 	if !found {
-		if pvc.Spec.StorageClassName != nil {
-			class = *pvc.Spec.StorageClassName
-		}
+		class = strPtrOrUnset(pvc.Spec.StorageClassName)
 	}
 
 	r.ID = client.MetaFQN(&pvc.ObjectMeta)
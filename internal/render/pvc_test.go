@@ -10,6 +10,7 @@ import (
 	"github.com/derailed/k9s/internal/render"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 )
 
 func TestPersistentVolumeClaimRender(t *testing.T) {
@@ -20,3 +21,38 @@ func TestPersistentVolumeClaimRender(t *testing.T) {
 	assert.Equal(t, "default/www-nginx-sts-0", r.ID)
 	assert.Equal(t, model1.Fields{"default", "www-nginx-sts-0", "Bound", "pvc-fbabd470-8725-11e9-a8e8-42010a80015b", "1Gi", "RWO", "standard"}, r.Fields[:7])
 }
+
+func TestPersistentVolumeClaimRenderClass(t *testing.T) {
+	uu := map[string]struct {
+		class, e string
+		set      bool
+	}{
+		"unset": {
+			e: render.UnsetValue,
+		},
+		"blank": {
+			set: true,
+		},
+		"set": {
+			class: "fast",
+			set:   true,
+			e:     "fast",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			o := load(t, "pvc")
+			unstructured.RemoveNestedField(o.Object, "spec", "storageClassName")
+			if u.set {
+				require.NoError(t, unstructured.SetNestedField(o.Object, u.class, "spec", "storageClassName"))
+			}
+
+			var c render.PersistentVolumeClaim
+			r := model1.NewRow(8)
+			require.NoError(t, c.Render(o, "", &r))
+			assert.Equal(t, u.e, r.Fields[6])
+		})
+	}
+}
@@ -34,10 +34,11 @@ func (r ReplicaSet) Header(_ string) model1.Header {
 var defaultRSHeader = model1.Header{
 	model1.HeaderColumn{Name: "NAMESPACE"},
 	model1.HeaderColumn{Name: "NAME"},
-	model1.HeaderColumn{Name: "VS", Attrs: model1.Attrs{VS: true}},
+	model1.HeaderColumn{Name: "VS", Attrs: model1.Attrs{VS: true, Decorator: VulDecorator}},
 	model1.HeaderColumn{Name: "DESIRED", Attrs: model1.Attrs{Align: tview.AlignRight}},
 	model1.HeaderColumn{Name: "CURRENT", Attrs: model1.Attrs{Align: tview.AlignRight}},
 	model1.HeaderColumn{Name: "READY", Attrs: model1.Attrs{Align: tview.AlignRight}},
+	model1.HeaderColumn{Name: "HASH", Attrs: model1.Attrs{Decorator: HashDecorator}},
 	model1.HeaderColumn{Name: "CONTAINERS", Attrs: model1.Attrs{Wide: true}},
 	model1.HeaderColumn{Name: "IMAGES", Attrs: model1.Attrs{Wide: true}},
 	model1.HeaderColumn{Name: "SELECTOR", Attrs: model1.Attrs{Wide: true}},
@@ -85,10 +86,11 @@ func (r ReplicaSet) defaultRow(raw *unstructured.Unstructured, row *model1.Row)
 	row.Fields = model1.Fields{
 		rs.Namespace,
 		rs.Name,
-		computeVulScore(rs.Namespace, rs.Labels, &rs.Spec.Template.Spec),
+		computeVulScore(rs.Namespace, rs.Labels, rs.Annotations, &rs.Spec.Template.Spec),
 		strconv.Itoa(int(*rs.Spec.Replicas)),
 		strconv.Itoa(int(rs.Status.Replicas)),
 		strconv.Itoa(int(rs.Status.ReadyReplicas)),
+		toTemplateHash(rs.Labels),
 		strings.Join(cos, ","),
 		strings.Join(imgs, ","),
 		mapToStr(rs.Labels),
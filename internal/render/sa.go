@@ -18,6 +18,7 @@ var defaultSAHeader = model1.Header{
 	model1.HeaderColumn{Name: "NAMESPACE"},
 	model1.HeaderColumn{Name: "NAME"},
 	model1.HeaderColumn{Name: "SECRET"},
+	model1.HeaderColumn{Name: "AUTOMOUNT", Attrs: model1.Attrs{Wide: true}},
 	model1.HeaderColumn{Name: "LABELS", Attrs: model1.Attrs{Wide: true}},
 	model1.HeaderColumn{Name: "VALID", Attrs: model1.Attrs{Wide: true}},
 	model1.HeaderColumn{Name: "AGE", Attrs: model1.Attrs{Time: true}},
@@ -66,6 +67,7 @@ func (ServiceAccount) defaultRow(raw *unstructured.Unstructured, r *model1.Row)
 		sa.Namespace,
 		sa.Name,
 		strconv.Itoa(len(sa.Secrets)),
+		boolPtrToStr3(sa.AutomountServiceAccountToken),
 		mapToStr(sa.Labels),
 		"",
 		ToAge(sa.GetCreationTimestamp()),
@@ -18,5 +18,5 @@ func TestServiceAccountRender(t *testing.T) {
 
 	require.NoError(t, c.Render(load(t, "sa"), "", &r))
 	assert.Equal(t, "default/blee", r.ID)
-	assert.Equal(t, model1.Fields{"default", "blee", "2"}, r.Fields[:3])
+	assert.Equal(t, model1.Fields{"default", "blee", "2", render.UnsetValue}, r.Fields[:4])
 }
@@ -19,6 +19,7 @@ var defaultSECHeader = model1.Header{
 	model1.HeaderColumn{Name: "NAME"},
 	model1.HeaderColumn{Name: "TYPE"},
 	model1.HeaderColumn{Name: "DATA"},
+	model1.HeaderColumn{Name: "KEYS", Attrs: model1.Attrs{Wide: true}},
 	model1.HeaderColumn{Name: "VALID", Attrs: model1.Attrs{Wide: true}},
 	model1.HeaderColumn{Name: "AGE", Attrs: model1.Attrs{Time: true}},
 }
@@ -67,9 +68,20 @@ func (Secret) defaultRow(raw *unstructured.Unstructured, r *model1.Row) error {
 		sec.Name,
 		string(sec.Type),
 		strconv.Itoa(len(sec.Data)),
+		mapToStrMasked(secretKeys(sec.Data)),
 		"",
 		ToAge(raw.GetCreationTimestamp()),
 	}
 
 	return nil
 }
+
+// secretKeys returns a secret data keys sans values.
+func secretKeys(data map[string][]byte) map[string]string {
+	kk := make(map[string]string, len(data))
+	for k := range data {
+		kk[k] = ""
+	}
+
+	return kk
+}
@@ -0,0 +1,22 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecretRender(t *testing.T) {
+	c := render.Secret{}
+	r := model1.NewRow(7)
+
+	require.NoError(t, c.Render(load(t, "sec"), "", &r))
+	assert.Equal(t, "default/s1", r.ID)
+	assert.Equal(t, model1.Fields{"default", "s1", "Opaque", "2", "password=••••,token=••••"}, r.Fields[:5])
+}
@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"encoding/json"
+	"log/slog"
+	"time"
+
+	"github.com/derailed/k9s/internal/slogs"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/cache"
+)
+
+const (
+	// SizeCol represents an optional column estimating an object serialized size.
+	SizeCol = "SIZE"
+
+	// LargeObjectSize flags objects getting close to etcd limits.
+	LargeObjectSize = 512 * 1024
+
+	sizeCacheSize   = 5_000
+	sizeCacheExpiry = 10 * time.Minute
+)
+
+var sizeCache = cache.NewLRUExpireCache(sizeCacheSize)
+
+type objSize struct {
+	total, managed int
+}
+
+// ToSize estimates a resource serialized size. Results are cached by resource version.
+func ToSize(o runtime.Object) string {
+	s, ok := sizeOf(o)
+	if !ok {
+		return NAValue
+	}
+	str := humanizeBytes(int64(s.total))
+	switch {
+	case s.total >= LargeObjectSize:
+		str += "(large)"
+	case s.managed*2 > s.total:
+		str += "(managedFields)"
+	}
+
+	return str
+}
+
+func sizeOf(o runtime.Object) (objSize, bool) {
+	m, err := meta.Accessor(o)
+	if err != nil {
+		return objSize{}, false
+	}
+	key := string(m.GetUID()) + ":" + m.GetResourceVersion()
+	if v, ok := sizeCache.Get(key); ok {
+		if s, ok := v.(objSize); ok {
+			return s, true
+		}
+	}
+
+	bb, err := json.Marshal(o)
+	if err != nil {
+		slog.Warn("Unable to compute object size", slogs.Error, err)
+		return objSize{}, false
+	}
+	s := objSize{total: len(bb)}
+	if ff := m.GetManagedFields(); len(ff) > 0 {
+		if bb, err := json.Marshal(ff); err == nil {
+			s.managed = len(bb)
+		}
+	}
+	if m.GetResourceVersion() != "" {
+		sizeCache.Add(key, s, sizeCacheExpiry)
+	}
+
+	return s, true
+}
@@ -0,0 +1,92 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestToSize(t *testing.T) {
+	uu := map[string]struct {
+		o runtime.Object
+		e string
+	}{
+		"small": {
+			o: makeSizedCM("cm1", "1", 10),
+			e: "106",
+		},
+		"large": {
+			o: makeSizedCM("cm2", "1", LargeObjectSize),
+			e: "512K(large)",
+		},
+		"managed": {
+			o: &unstructured.Unstructured{Object: map[string]any{
+				"kind": "ConfigMap",
+				"metadata": map[string]any{
+					"name":            "cm3",
+					"uid":             "cm3",
+					"resourceVersion": "1",
+					"managedFields": []any{
+						map[string]any{"manager": strings.Repeat("m", 200)},
+					},
+				},
+			}},
+			e: "313(managedFields)",
+		},
+		"no-meta": {
+			o: &runtime.Unknown{},
+			e: NAValue,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, ToSize(u.o))
+		})
+	}
+}
+
+func TestToSizeCached(t *testing.T) {
+	o := makeSizedCM("cm-cached", "1", 10)
+	e := ToSize(o)
+
+	_ = unstructured.SetNestedField(o.Object, strings.Repeat("a", 1_000), "data", "a")
+	assert.Equal(t, e, ToSize(o))
+
+	o.SetResourceVersion("2")
+	assert.NotEqual(t, e, ToSize(o))
+}
+
+func TestSizeCustomColumn(t *testing.T) {
+	specs, err := NewColsSpecs("NAME", SizeCol+"|R").parseSpecs()
+	require.NoError(t, err)
+
+	o := makeSizedCM("cm-col", "1", 10)
+	h := model1.Header{model1.HeaderColumn{Name: "NAME"}}
+	row := model1.Row{Fields: model1.Fields{"cm-col"}}
+	cols, err := specs.realize(o, h, &row)
+	require.NoError(t, err)
+	require.Len(t, cols, 2)
+	assert.Equal(t, "112", cols[1].Value)
+}
+
+func makeSizedCM(n, rv string, size int) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"kind": "ConfigMap",
+		"metadata": map[string]any{
+			"name":            n,
+			"uid":             n,
+			"resourceVersion": rv,
+		},
+		"data": map[string]any{"a": strings.Repeat("a", size)},
+	}}
+}
@@ -17,7 +17,7 @@ import (
 var defaultSTSHeader = model1.Header{
 	model1.HeaderColumn{Name: "NAMESPACE"},
 	model1.HeaderColumn{Name: "NAME"},
-	model1.HeaderColumn{Name: "VS", Attrs: model1.Attrs{VS: true}},
+	model1.HeaderColumn{Name: "VS", Attrs: model1.Attrs{VS: true, Decorator: VulDecorator}},
 	model1.HeaderColumn{Name: "READY"},
 	model1.HeaderColumn{Name: "SELECTOR", Attrs: model1.Attrs{Wide: true}},
 	model1.HeaderColumn{Name: "SERVICE"},
@@ -74,7 +74,7 @@ func (s StatefulSet) defaultRow(raw *unstructured.Unstructured, r *model1.Row) e
 	r.Fields = model1.Fields{
 		sts.Namespace,
 		sts.Name,
-		computeVulScore(sts.Namespace, sts.Labels, &sts.Spec.Template.Spec),
+		computeVulScore(sts.Namespace, sts.Labels, sts.Annotations, &sts.Spec.Template.Spec),
 		strconv.Itoa(int(sts.Status.ReadyReplicas)) + "/" + strconv.Itoa(int(desired)),
 		asSelector(sts.Spec.Selector),
 		na(sts.Spec.ServiceName),
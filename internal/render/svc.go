@@ -25,6 +25,9 @@ var defaultSVCHeader = model1.Header{
 	model1.HeaderColumn{Name: "EXTERNAL-IP"},
 	model1.HeaderColumn{Name: "SELECTOR", Attrs: model1.Attrs{Wide: true}},
 	model1.HeaderColumn{Name: "PORTS", Attrs: model1.Attrs{Wide: false}},
+	model1.HeaderColumn{Name: "TRAFFIC-POLICY", Attrs: model1.Attrs{Wide: true}},
+	model1.HeaderColumn{Name: "NODE-PORTS", Attrs: model1.Attrs{Wide: true}},
+	model1.HeaderColumn{Name: "LB-INGRESS", Attrs: model1.Attrs{Wide: true}},
 	model1.HeaderColumn{Name: "LABELS", Attrs: model1.Attrs{Wide: true}},
 	model1.HeaderColumn{Name: "VALID", Attrs: model1.Attrs{Wide: true}},
 	model1.HeaderColumn{Name: "AGE", Attrs: model1.Attrs{Time: true}},
@@ -77,6 +80,9 @@ func (s Service) defaultRow(raw *unstructured.Unstructured, r *model1.Row) error
 		toIPs(svc.Spec.Type, getSvcExtIPS(&svc)),
 		mapToStr(svc.Spec.Selector),
 		ToPorts(svc.Spec.Ports),
+		string(svc.Spec.ExternalTrafficPolicy),
+		toNodePorts(svc.Spec.Ports),
+		toLBIngress(&svc),
 		mapToStr(svc.Labels),
 		AsStatus(s.diagnose()),
 		ToAge(svc.GetCreationTimestamp()),
@@ -137,6 +143,28 @@ func lbIngressIP(s v1.LoadBalancerStatus) string {
 	return strings.Join(result, ",")
 }
 
+func toNodePorts(pp []v1.ServicePort) string {
+	ports := make([]string, 0, len(pp))
+	for _, p := range pp {
+		if p.NodePort != 0 {
+			ports = append(ports, strconv.Itoa(int(p.NodePort)))
+		}
+	}
+
+	return strings.Join(ports, ",")
+}
+
+func toLBIngress(svc *v1.Service) string {
+	if svc.Spec.Type != v1.ServiceTypeLoadBalancer {
+		return ""
+	}
+	if ips := lbIngressIP(svc.Status.LoadBalancer); ips != "" {
+		return ips
+	}
+
+	return "<pending>"
+}
+
 func toIPs(svcType v1.ServiceType, ips []string) string {
 	if len(ips) == 0 {
 		if svcType == v1.ServiceTypeLoadBalancer {
@@ -10,6 +10,7 @@ import (
 	"github.com/derailed/k9s/internal/render"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 )
 
 func TestServiceRender(t *testing.T) {
@@ -21,6 +22,76 @@ func TestServiceRender(t *testing.T) {
 	assert.Equal(t, model1.Fields{"default", "dictionary1", "ClusterIP", "10.47.248.116", "", "app=dictionary1", "http:4001►0"}, r.Fields[:7])
 }
 
+func TestServiceRenderLB(t *testing.T) {
+	uu := map[string]struct {
+		spec, status map[string]any
+		e            model1.Fields
+	}{
+		"cluster-ip": {
+			spec: map[string]any{
+				"type":  "ClusterIP",
+				"ports": []any{map[string]any{"port": int64(80), "protocol": "TCP"}},
+			},
+			e: model1.Fields{"", "", ""},
+		},
+		"node-port": {
+			spec: map[string]any{
+				"type":                  "NodePort",
+				"externalTrafficPolicy": "Local",
+				"ports": []any{
+					map[string]any{"port": int64(80), "nodePort": int64(30080), "protocol": "TCP"},
+					map[string]any{"port": int64(443), "nodePort": int64(30443), "protocol": "TCP"},
+				},
+			},
+			e: model1.Fields{"Local", "30080,30443", ""},
+		},
+		"lb-pending": {
+			spec: map[string]any{
+				"type":                  "LoadBalancer",
+				"externalTrafficPolicy": "Cluster",
+				"ports":                 []any{map[string]any{"port": int64(80), "nodePort": int64(31000), "protocol": "TCP"}},
+			},
+			e: model1.Fields{"Cluster", "31000", "<pending>"},
+		},
+		"lb": {
+			spec: map[string]any{
+				"type":                  "LoadBalancer",
+				"externalTrafficPolicy": "Cluster",
+				"ports":                 []any{map[string]any{"port": int64(80), "nodePort": int64(31000), "protocol": "TCP"}},
+			},
+			status: map[string]any{
+				"loadBalancer": map[string]any{
+					"ingress": []any{
+						map[string]any{"ip": "1.2.3.4"},
+						map[string]any{"hostname": "lb.example.com"},
+					},
+				},
+			},
+			e: model1.Fields{"Cluster", "31000", "1.2.3.4,lb.example.com"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			o := unstructured.Unstructured{Object: map[string]any{
+				"apiVersion": "v1",
+				"kind":       "Service",
+				"metadata":   map[string]any{"namespace": "default", "name": "fred"},
+				"spec":       u.spec,
+			}}
+			if u.status != nil {
+				o.Object["status"] = u.status
+			}
+
+			var c render.Service
+			r := model1.NewRow(13)
+			require.NoError(t, c.Render(&o, "", &r))
+			assert.Equal(t, u.e, r.Fields[7:10])
+		})
+	}
+}
+
 func BenchmarkSvcRender(b *testing.B) {
 	var (
 		svc render.Service
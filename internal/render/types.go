@@ -47,6 +47,9 @@ const (
 	// UnsetValue represent an unset value.
 	UnsetValue = "<unset>"
 
+	// UnscheduledValue represents a pod not yet assigned to a node.
+	UnscheduledValue = "<unscheduled>"
+
 	// ZeroValue represents a zero value.
 	ZeroValue = "0"
 )
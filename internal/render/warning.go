@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Warning renders api server warnings to screen.
+type Warning struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (Warning) ColorerFunc() model1.ColorerFunc {
+	return func(string, model1.Header, *model1.RowEvent) tcell.Color {
+		return tcell.ColorOrange
+	}
+}
+
+// Header returns a header row.
+func (Warning) Header(string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "MESSAGE"},
+		model1.HeaderColumn{Name: "COUNT", Attrs: model1.Attrs{Align: tview.AlignRight}},
+		model1.HeaderColumn{Name: "AGENT", Attrs: model1.Attrs{Wide: true}},
+		model1.HeaderColumn{Name: "LAST"},
+		model1.HeaderColumn{Name: "VALID", Attrs: model1.Attrs{Wide: true}},
+		model1.HeaderColumn{Name: "AGE", Attrs: model1.Attrs{Time: true}},
+	}
+}
+
+// Render renders a warning to screen.
+func (Warning) Render(o any, _ string, r *model1.Row) error {
+	w, ok := o.(WarningRes)
+	if !ok {
+		return fmt.Errorf("expecting WarningRes, but got %T", o)
+	}
+
+	r.ID = w.Warning.ID
+	r.Fields = model1.Fields{
+		w.Warning.Text,
+		humanizeCount(int64(w.Warning.Count)),
+		w.Warning.Agent,
+		timeToAge(w.Warning.Last),
+		"",
+		timeToAge(w.Warning.First),
+	}
+
+	return nil
+}
+
+// WarningRes represents an api server warning resource.
+type WarningRes struct {
+	Warning client.Warning
+}
+
+// GetObjectKind returns a schema object.
+func (WarningRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a warning copy.
+func (w WarningRes) DeepCopyObject() runtime.Object {
+	return w
+}
@@ -7,6 +7,7 @@ import (
 	"log/slog"
 	"os"
 	"sync"
+	"sync/atomic"
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config"
@@ -27,6 +28,7 @@ type App struct {
 	views   map[string]tview.Primitive
 	cmdBuff *model.FishBuff
 	running bool
+	bell    atomic.Bool
 	mx      sync.RWMutex
 }
 
@@ -59,6 +61,22 @@ func (a *App) Init() {
 	a.Styles.AddListener(a)
 
 	a.SetRoot(a.Main, true).EnableMouse(a.Config.K9s.UI.EnableMouse)
+	a.SetAfterDrawFunc(a.ringBell)
+}
+
+// Bell rings the terminal bell on the next screen draw.
+func (a *App) Bell() {
+	a.bell.Store(true)
+	a.QueueUpdateDraw(func() {})
+}
+
+func (a *App) ringBell(s tcell.Screen) {
+	if !a.bell.CompareAndSwap(true, false) {
+		return
+	}
+	if err := s.Beep(); err != nil {
+		slog.Warn("Terminal bell failed", slogs.Error, err)
+	}
 }
 
 // QueueUpdate queues up a ui action.
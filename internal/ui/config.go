@@ -14,6 +14,7 @@ import (
 	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/slogs"
 	"github.com/fsnotify/fsnotify"
 )
@@ -157,6 +158,9 @@ func (c *Configurator) ConfigWatcher(ctx context.Context, s synchronizer) error
 							s.Logo().Warn("Context config reload failed!")
 						}
 					}
+					if c.Config.K9s != nil {
+						render.SetCPUUnit(c.Config.K9s.UI.CPUUnit)
+					}
 					s.QueueUpdateDraw(func() {
 						c.RefreshStyles(s)
 					})
@@ -6,18 +6,25 @@ package ui
 import (
 	"fmt"
 	"strings"
+	"sync"
 
 	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/tview"
 )
 
+// CounterFunc returns the number of resources tracked by a component if known.
+type CounterFunc func(model.Component) (int, bool)
+
 // Crumbs represents user breadcrumbs.
 type Crumbs struct {
 	*tview.TextView
 
-	styles *config.Styles
-	stack  *model.Stack
+	styles  *config.Styles
+	stack   *model.Stack
+	counter CounterFunc
+	text    string
+	mx      sync.Mutex
 }
 
 // NewCrumbs returns a new breadcrumb view.
@@ -40,35 +47,61 @@ func NewCrumbs(styles *config.Styles) *Crumbs {
 func (c *Crumbs) StylesChanged(s *config.Styles) {
 	c.styles = s
 	c.SetBackgroundColor(s.BgColor())
-	c.refresh(c.stack.Flatten())
+	c.refresh()
 }
 
 // StackPushed indicates a new item was added.
 func (c *Crumbs) StackPushed(comp model.Component) {
 	c.stack.Push(comp)
-	c.refresh(c.stack.Flatten())
+	c.refresh()
 }
 
 // StackPopped indicates an item was deleted.
 func (c *Crumbs) StackPopped(_, _ model.Component) {
 	c.stack.Pop()
-	c.refresh(c.stack.Flatten())
+	c.refresh()
 }
 
 // StackTop indicates the top of the stack.
 func (*Crumbs) StackTop(model.Component) {}
 
-// Refresh updates view with new crumbs.
-func (c *Crumbs) refresh(crumbs []string) {
-	c.Clear()
-	last, bgColor := len(crumbs)-1, c.styles.Frame().Crumb.BgColor
-	for i, crumb := range crumbs {
+// SetCounter sets a function to decorate crumbs with resource counts.
+func (c *Crumbs) SetCounter(f CounterFunc) {
+	c.counter = f
+}
+
+// Refresh updates crumbs resource counts and reports whether they changed.
+func (c *Crumbs) Refresh() bool {
+	return c.refresh()
+}
+
+func (c *Crumbs) refresh() bool {
+	var b strings.Builder
+	cc := c.stack.Peek()
+	last, bgColor := len(cc)-1, c.styles.Frame().Crumb.BgColor
+	for i, comp := range cc {
 		if i == last {
 			bgColor = c.styles.Frame().Crumb.ActiveColor
 		}
-		_, _ = fmt.Fprintf(c, "[%s:%s:b] <%s> [-:%s:-] ",
+		crumb := strings.ReplaceAll(strings.ToLower(comp.Name()), " ", "")
+		if c.counter != nil {
+			if n, ok := c.counter(comp); ok {
+				crumb += fmt.Sprintf("(%d)", n)
+			}
+		}
+		_, _ = fmt.Fprintf(&b, "[%s:%s:b] <%s> [-:%s:-] ",
 			c.styles.Frame().Crumb.FgColor,
-			bgColor, strings.ReplaceAll(strings.ToLower(crumb), " ", ""),
+			bgColor, crumb,
 			c.styles.Body().BgColor)
 	}
+
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	if s := b.String(); s != c.text {
+		c.text = s
+		c.SetText(s)
+		return true
+	}
+
+	return false
 }
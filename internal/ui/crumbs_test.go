@@ -31,6 +31,33 @@ func TestNewCrumbs(t *testing.T) {
 	assert.Equal(t, "[#000000:#00ffff:b] <c1> [-:#000000:-] [#000000:#00ffff:b] <c2> [-:#000000:-] [#000000:#ffa500:b] <c3> [-:#000000:-] \n", v.GetText(false))
 }
 
+func TestCrumbsCounter(t *testing.T) {
+	v := ui.NewCrumbs(config.NewStyles())
+	v.SetCounter(func(c model.Component) (int, bool) {
+		return 42, c.Name() == "pods"
+	})
+	v.StackPushed(makeComponent("ctx"))
+	v.StackPushed(makeComponent("pods"))
+
+	assert.Contains(t, v.GetText(false), "<ctx> ")
+	assert.Contains(t, v.GetText(false), "<pods(42)> ")
+}
+
+func TestCrumbsRefresh(t *testing.T) {
+	v := ui.NewCrumbs(config.NewStyles())
+	n := 1
+	v.SetCounter(func(model.Component) (int, bool) {
+		return n, true
+	})
+	v.StackPushed(makeComponent("pods"))
+
+	assert.False(t, v.Refresh())
+	n = 2
+	assert.True(t, v.Refresh())
+	assert.Contains(t, v.GetText(false), "<pods(2)> ")
+	assert.False(t, v.Refresh())
+}
+
 // Helpers...
 
 type c struct {
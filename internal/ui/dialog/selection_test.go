@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dialog
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShowSelection(t *testing.T) {
+	uu := map[string]struct {
+		keys []tcell.Key
+		e    int
+	}{
+		"first": {
+			keys: []tcell.Key{tcell.KeyEnter},
+			e:    0,
+		},
+		"next": {
+			keys: []tcell.Key{tcell.KeyDown, tcell.KeyEnter},
+			e:    1,
+		},
+		"wrap-last": {
+			keys: []tcell.Key{tcell.KeyUp, tcell.KeyEnter},
+			e:    2,
+		},
+		"wrap-first": {
+			keys: []tcell.Key{tcell.KeyDown, tcell.KeyDown, tcell.KeyDown, tcell.KeyEnter},
+			e:    0,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p := ui.NewPages()
+			selected := -1
+			ShowSelection(new(config.Dialog), p, "Blee", []string{"a", "b", "c"}, func(i int) {
+				selected = i
+			})
+
+			m := p.GetPrimitive(dialogKey).(*ui.ModalList)
+			assert.NotNil(t, m)
+			m.Focus(func(p tview.Primitive) { p.Focus(nil) })
+			for _, key := range u.keys {
+				m.InputHandler()(tcell.NewEventKey(key, 0, tcell.ModNone), func(tview.Primitive) {})
+			}
+
+			assert.Equal(t, u.e, selected)
+			assert.Nil(t, p.GetPrimitive(dialogKey))
+		})
+	}
+}
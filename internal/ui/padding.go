@@ -9,6 +9,7 @@ import (
 
 	"github.com/derailed/k9s/internal/model1"
 	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/tview"
 )
 
 // MaxyPad tracks uniform column padding.
@@ -58,3 +59,13 @@ func Pad(s string, width int) string {
 	}
 	return s + strings.Repeat(" ", width-len(s))
 }
+
+// PadTagged pads a string to a given width ignoring any color tags.
+func PadTagged(s string, width int) string {
+	w := tview.TaggedStringWidth(s)
+	if w >= width {
+		return s
+	}
+
+	return s + strings.Repeat(" ", width-w)
+}
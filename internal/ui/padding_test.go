@@ -122,6 +122,23 @@ func TestPad(t *testing.T) {
 	}
 }
 
+func TestPadTagged(t *testing.T) {
+	uu := []struct {
+		s string
+		l int
+		e string
+	}{
+		{"fred", 6, "fred  "},
+		{"[aqua::]fred[-::]", 6, "[aqua::]fred[-::]  "},
+		{"[aqua::]fred[-::]", 4, "[aqua::]fred[-::]"},
+		{"[aqua::]fred[-::]", 2, "[aqua::]fred[-::]"},
+	}
+
+	for _, u := range uu {
+		assert.Equal(t, u.e, PadTagged(u.s, u.l))
+	}
+}
+
 func BenchmarkMaxColumn(b *testing.B) {
 	table := model1.NewTableDataWithRows(
 		client.NewGVR("test"),
@@ -273,6 +273,11 @@ func (t *Table) GetFilteredData() *model1.TableData {
 	return t.filtered(t.GetModel().Peek())
 }
 
+// DecorateFn returns the current row decorator.
+func (t *Table) DecorateFn() DecorateFunc {
+	return t.decorateFn
+}
+
 // SetDecorateFn specifies the default row decorator.
 func (t *Table) SetDecorateFn(f DecorateFunc) {
 	t.decorateFn = f
@@ -384,6 +389,7 @@ func (t *Table) buildRow(r int, re, ore model1.RowEvent, h model1.Header, pads M
 			continue
 		}
 
+		var delta string
 		if !re.Deltas.IsBlank() && !h.IsTimeCol(c) {
 			var old string
 			if c < len(ore.Deltas) {
@@ -392,14 +398,20 @@ func (t *Table) buildRow(r int, re, ore model1.RowEvent, h model1.Header, pads M
 			if c < len(re.Deltas) {
 				old = re.Deltas[c]
 			}
-			field += Deltas(old, field)
+			delta = Deltas(old, field)
 		}
 
-		if h[c].Decorator != nil {
-			field = h[c].Decorator(field)
-		}
-		if h[c].Align == tview.AlignLeft {
-			field = formatCell(field, pads[c])
+		switch {
+		case h[c].Decorator != nil:
+			// Decorators see the raw value so the delta marker does not skew them.
+			field = h[c].Decorator(field) + delta
+			if h[c].Align == tview.AlignLeft {
+				field = PadTagged(field, pads[c])
+			}
+		case h[c].Align == tview.AlignLeft:
+			field = formatCell(field+delta, pads[c])
+		default:
+			field += delta
 		}
 
 		cell := tview.NewTableCell(field)
@@ -5,6 +5,7 @@ package ui_test
 
 import (
 	"context"
+	"strings"
 	"testing"
 	"time"
 
@@ -40,6 +41,35 @@ func TestTableUpdate(t *testing.T) {
 	assert.Equal(t, data.HeaderCount(), v.GetColumnCount())
 }
 
+func TestTableDecoratorDelta(t *testing.T) {
+	v := ui.NewTable(client.NewGVR("fred"))
+	v.Init(makeContext())
+
+	data := model1.NewTableDataWithRows(
+		client.NewGVR("test"),
+		model1.Header{
+			model1.HeaderColumn{Name: "A", Attrs: model1.Attrs{Decorator: func(s string) string {
+				if s != "2" {
+					return s
+				}
+				return "<" + s + ">"
+			}}},
+		},
+		model1.NewRowEventsWithEvts(
+			model1.RowEvent{
+				Row:    model1.Row{ID: "r1", Fields: model1.Fields{"2"}},
+				Deltas: model1.DeltaRow{"1"},
+			},
+		),
+	)
+	cdata := v.Update(data, false)
+	v.UpdateUI(cdata, data)
+
+	cell := v.GetCell(1, 0).Text
+	assert.True(t, strings.HasPrefix(cell, "<2>"), cell)
+	assert.Contains(t, cell, ui.Deltas("1", "2"))
+}
+
 func TestTableSelection(t *testing.T) {
 	v := ui.NewTable(client.NewGVR("fred"))
 	v.Init(makeContext())
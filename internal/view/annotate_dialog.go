@@ -0,0 +1,142 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tview"
+)
+
+const annotateKey = "annotate"
+
+// ShowReconcile pokes resources using their configured reconcile trigger.
+// Resources without a known trigger fall back to the annotate dialog.
+func ShowReconcile(view ResourceViewer, paths []string) {
+	app := view.App()
+	r, ok := app.Config.K9s.Reconcilers.For(view.GVR())
+	if !ok {
+		ShowAnnotate(view, paths)
+		return
+	}
+	if err := r.Validate(); err != nil {
+		app.Flash().Errf("Invalid reconciler for %s: %s", view.GVR(), err)
+		return
+	}
+
+	kind, key := "annotation", r.Annotation
+	if r.Label != "" {
+		kind, key = "label", r.Label
+	}
+	msg := fmt.Sprintf("Reconcile %s %s (%s %s)?", singularize(view.GVR().R()), paths[0], kind, key)
+	if len(paths) > 1 {
+		msg = fmt.Sprintf("Reconcile %d marked %s (%s %s)?", len(paths), view.GVR().R(), kind, key)
+	}
+	d := app.Styles.Dialog()
+	dialog.ShowConfirm(&d, app.Content.Pages, "Confirm Reconcile", msg, func() {
+		annotations, labels := r.Trigger(time.Now())
+		touch(view, paths, annotations, labels, "Reconcile requested")
+	}, func() {})
+}
+
+// ShowAnnotate pops a dialog to set or remove an annotation on resources.
+func ShowAnnotate(view ResourceViewer, paths []string) {
+	var (
+		app    = view.App()
+		styles = app.Styles.Dialog()
+		key    string
+		value  = time.Now().UTC().Format(time.RFC3339)
+	)
+
+	f := tview.NewForm().
+		SetItemPadding(0).
+		SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(styles.ButtonBgColor.Color()).
+		SetButtonTextColor(styles.ButtonFgColor.Color()).
+		SetLabelColor(styles.LabelFgColor.Color()).
+		SetFieldTextColor(styles.FieldFgColor.Color()).
+		SetFieldBackgroundColor(styles.BgColor.Color())
+
+	f.AddInputField("Key:", "", 0, nil, func(v string) {
+		key = strings.TrimSpace(v)
+	})
+	f.AddInputField("Value:", value, 0, nil, func(v string) {
+		value = v
+	})
+
+	pages := app.Content.Pages
+	f.AddButton("Cancel", func() {
+		DismissAnnotate(view, pages)
+	})
+	f.AddButton("Remove", func() {
+		if key == "" {
+			app.Flash().Warn("Annotation key must be specified")
+			return
+		}
+		DismissAnnotate(view, pages)
+		touch(view, paths, map[string]string{key: ""}, nil, "Annotation removed")
+	})
+	f.AddButton("OK", func() {
+		if key == "" || value == "" {
+			app.Flash().Warn("Annotation key and value must be specified")
+			return
+		}
+		DismissAnnotate(view, pages)
+		touch(view, paths, map[string]string{key: value}, nil, "Annotation set")
+	})
+	for i := range 3 {
+		if b := f.GetButton(i); b != nil {
+			b.SetBackgroundColorActivated(styles.ButtonFocusBgColor.Color())
+			b.SetLabelColorActivated(styles.ButtonFocusFgColor.Color())
+		}
+	}
+
+	msg := fmt.Sprintf("Annotate %s %s", singularize(view.GVR().R()), paths[0])
+	if len(paths) > 1 {
+		msg = fmt.Sprintf("Annotate %d marked %s", len(paths), view.GVR().R())
+	}
+	modal := tview.NewModalForm("<Annotate>", f)
+	modal.SetText(msg)
+	modal.SetDoneFunc(func(int, string) {
+		DismissAnnotate(view, pages)
+	})
+
+	pages.AddPage(annotateKey, modal, false, true)
+	pages.ShowPage(annotateKey)
+	app.SetFocus(pages.GetPrimitive(annotateKey))
+}
+
+// DismissAnnotate dismiss the annotate dialog.
+func DismissAnnotate(v ResourceViewer, p *ui.Pages) {
+	p.RemovePage(annotateKey)
+	v.App().SetFocus(p.CurrentPage().Item)
+}
+
+func touch(view ResourceViewer, paths []string, annotations, labels map[string]string, msg string) {
+	app := view.App()
+	var failed int
+	for _, path := range paths {
+		err := dao.Touch(context.Background(), app.factory, view.GVR(), path, annotations, labels)
+		app.audit(config.AuditAnnotate, view.GVR(), path, err)
+		if err != nil {
+			failed++
+			app.Flash().Err(err)
+		}
+	}
+	if failed > 0 {
+		return
+	}
+	if len(paths) > 1 {
+		app.Flash().Infof("%s for %d marked %s", msg, len(paths), view.GVR().R())
+		return
+	}
+	app.Flash().Infof("%s for %s", msg, paths[0])
+}
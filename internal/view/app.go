@@ -21,7 +21,9 @@ import (
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/slogs"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/k9s/internal/ui/dialog"
@@ -38,6 +40,7 @@ var ExitStatus = ""
 const (
 	splashDelay      = 1 * time.Second
 	clusterRefresh   = 15 * time.Second
+	countRefresh     = 5 * time.Second
 	clusterInfoWidth = 50
 	clusterInfoPad   = 15
 )
@@ -51,6 +54,9 @@ type App struct {
 	factory       *watch.Factory
 	cancelFn      context.CancelFunc
 	clusterModel  *model.ClusterInfo
+	conditions    *model.Conditions
+	notes         *config.Notes
+	snapshots     *dao.Snapshots
 	cmdHistory    *model.History
 	filterHistory *model.History
 	conRetry      int32
@@ -66,6 +72,8 @@ func NewApp(cfg *config.Config) *App {
 		cmdHistory:    model.NewHistory(model.MaxHistory),
 		filterHistory: model.NewHistory(model.MaxHistory),
 		Content:       NewPageStack(),
+		notes:         config.NewNotes(),
+		snapshots:     dao.NewSnapshots(),
 	}
 	a.ReloadStyles()
 
@@ -87,6 +95,12 @@ func (a *App) UpdateClusterInfo() {
 	}
 }
 
+func (a *App) loadNotes() {
+	if err := a.notes.Load(a.Config.ContextNotesPath()); err != nil {
+		slog.Warn("Notes load failed", slogs.Error, err)
+	}
+}
+
 // ConOK checks the connection is cool, returns false otherwise.
 func (a *App) ConOK() bool {
 	return atomic.LoadInt32(&a.conRetry) == 0
@@ -95,6 +109,7 @@ func (a *App) ConOK() bool {
 // Init initializes the application.
 func (a *App) Init(version string, _ int) error {
 	a.version = model.NormalizeVersion(version)
+	render.SetCPUUnit(a.Config.K9s.UI.CPUUnit)
 
 	ctx := context.WithValue(context.Background(), internal.KeyApp, a)
 	if err := a.Content.Init(ctx); err != nil {
@@ -102,6 +117,7 @@ func (a *App) Init(version string, _ int) error {
 	}
 	a.Content.AddListener(a.Crumbs())
 	a.Content.AddListener(a.Menu())
+	a.Crumbs().SetCounter(a.resourceCount)
 
 	a.App.Init()
 	a.SetInputCapture(a.keyboard)
@@ -109,10 +125,15 @@ func (a *App) Init(version string, _ int) error {
 	if a.Conn() == nil {
 		return errors.New("no client connection detected")
 	}
+	if cfg := a.Conn().Config(); cfg != nil {
+		cfg.Warnings().SetListener(a)
+	}
 	ns := a.Config.ActiveNamespace()
 
 	a.factory = watch.NewFactory(a.Conn())
 	a.initFactory(ns)
+	a.conditions = model.NewConditions(a.factory, a)
+	a.loadNotes()
 
 	a.clusterModel = model.NewClusterInfo(a.factory, a.version, a.Config.K9s)
 	a.clusterModel.AddListener(a.clusterInfo())
@@ -252,11 +273,50 @@ func (a *App) bindKeys() {
 		ui.KeyRightBracket: ui.NewSharedKeyAction("Go Forward", a.nextCommand, false),
 		ui.KeyDash:         ui.NewSharedKeyAction("Last View", a.lastCommand, false),
 		tcell.KeyCtrlA:     ui.NewSharedKeyAction("Aliases", a.aliasCmd, false),
+		tcell.KeyCtrlT:     ui.NewSharedKeyAction("Incident Time", a.incidentCmd, false),
+		tcell.KeyCtrlV:     ui.NewSharedKeyAction("Switch Context", a.quickSwitchCmd, false),
 		tcell.KeyEnter:     ui.NewKeyAction("Goto", a.gotoCmd, false),
 		tcell.KeyCtrlC:     ui.NewKeyAction("Quit", a.quitCmd, false),
 	}))
 }
 
+// ConditionFired notifies a watched condition was met.
+func (a *App) ConditionFired(c *model.Condition, fqn, msg string) {
+	a.Bell()
+	a.Flash().Pin(model.FlashWarn, fmt.Sprintf("Condition %q met on %s %s: %s", c.Expr, c.GVR.R(), fqn, msg))
+}
+
+// APIWarning notifies the api server returned a new warning.
+func (a *App) APIWarning(w client.Warning) {
+	a.Flash().Warnf("API Warning: %s", w.Text)
+}
+
+// audit records a mutating action to the audit log when enabled.
+func (a *App) audit(action string, gvr *client.GVR, path string, err error) {
+	if !a.Config.K9s.Audit.Audits(action) {
+		return
+	}
+	r := config.AuditRecord{
+		Context: a.Config.ActiveContextName(),
+		Action:  action,
+		GVR:     gvr.String(),
+		Target:  path,
+	}
+	if cfg := a.Conn().Config(); cfg != nil {
+		r.User, _ = cfg.CurrentUserName()
+	}
+	if err != nil {
+		r.Error = err.Error()
+	}
+	if e := a.Config.K9s.Audit.Record(r); e != nil {
+		slog.Warn("Unable to record audit entry",
+			slogs.Verb, action,
+			slogs.Path, a.Config.K9s.Audit.Path(),
+			slogs.Error, e,
+		)
+	}
+}
+
 // ActiveView returns the currently active view.
 func (a *App) ActiveView() model.Component {
 	return a.Content.GetPrimitive("main").(model.Component)
@@ -336,6 +396,7 @@ func (a *App) Resume() {
 	ctx, a.cancelFn = context.WithCancel(context.Background())
 
 	go a.clusterUpdater(ctx)
+	go a.countUpdater(ctx)
 
 	if a.Config.K9s.UI.Reactive {
 		if err := a.ConfigWatcher(ctx, a); err != nil {
@@ -378,6 +439,32 @@ func (a *App) clusterUpdater(ctx context.Context) {
 	}
 }
 
+func (a *App) countUpdater(ctx context.Context) {
+	ticker := time.NewTicker(countRefresh)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if a.Crumbs().Refresh() {
+				a.Draw()
+			}
+		}
+	}
+}
+
+// resourceCount returns a view's resource count from the informer cache if already watched.
+func (a *App) resourceCount(c model.Component) (int, bool) {
+	v, ok := c.(ResourceViewer)
+	if !ok || a.factory == nil {
+		return 0, false
+	}
+
+	return a.factory.Count(v.GVR(), v.GetTable().GetModel().GetNamespace())
+}
+
 func (a *App) refreshCluster(context.Context) error {
 	c := a.Content.Top()
 	if ok := a.Conn().CheckConnectivity(); ok {
@@ -403,10 +490,17 @@ func (a *App) refreshCluster(context.Context) error {
 			slogs.MaxRetries, maxConnRetry,
 		)
 		ExitStatus = fmt.Sprintf("Lost K8s connection (%d). Bailing out!", count)
+		if err := a.Conn().AuthError(); err != nil {
+			ExitStatus = err.Error()
+		}
 		a.BailOut(1)
 	}
 	if count > 0 {
-		a.Status(model.FlashWarn, fmt.Sprintf("Dial K8s Toast [%d/%d]", count, maxConnRetry))
+		if e, ok := client.AsAuthError(a.Conn().AuthError()); ok {
+			a.Status(model.FlashWarn, fmt.Sprintf("Re-authenticating [%d/%d]. %s", count, maxConnRetry, e.Hint))
+		} else {
+			a.Status(model.FlashWarn, fmt.Sprintf("Dial K8s Toast [%d/%d]", count, maxConnRetry))
+		}
 		return fmt.Errorf("conn check failed (%d/%d)", count, maxConnRetry)
 	}
 
@@ -475,6 +569,12 @@ func (a *App) switchContext(ci *cmd.Interpreter, force bool) error {
 			slog.Error("Fail to save config to disk", slogs.Subsys, "config", slogs.Error, err)
 		}
 		a.initFactory(ns)
+		a.conditions.Clear()
+		a.snapshots.Clear()
+		if cfg := a.Conn().Config(); cfg != nil {
+			cfg.Warnings().Clear()
+		}
+		a.loadNotes()
 		if err := a.command.Reset(a.Config.ContextAliasesPath(), true); err != nil {
 			return err
 		}
@@ -744,6 +844,15 @@ func (a *App) lastCommand(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (a *App) incidentCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if a.Prompt().InCmdMode() {
+		return evt
+	}
+	ShowIncident(a)
+
+	return nil
+}
+
 func (a *App) aliasCmd(*tcell.EventKey) *tcell.EventKey {
 	if a.Content.Top() != nil && a.Content.Top().Name() == aliasTitle {
 		a.Content.Pop()
@@ -16,8 +16,10 @@ import (
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/config/data"
 	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/health"
 	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/model1"
 	"github.com/derailed/k9s/internal/slogs"
@@ -36,6 +38,7 @@ type Browser struct {
 	*Table
 
 	namespaces map[int]string
+	activity   *model.Activity
 	meta       *metav1.APIResource
 	accessor   dao.Accessor
 	contextFn  ContextFunc
@@ -43,6 +46,7 @@ type Browser struct {
 	mx         sync.RWMutex
 	updating   bool
 	firstView  atomic.Int32
+	verdicts   map[string]health.Verdict
 }
 
 // NewBrowser returns a new browser.
@@ -98,6 +102,11 @@ func (b *Browser) Init(ctx context.Context) error {
 	b.SetReadOnly(b.app.Config.IsReadOnly())
 	b.SetNoIcon(b.app.Config.K9s.UI.NoIcons)
 	b.SetFullGVR(b.app.Config.K9s.UI.UseFullGVRTitle)
+	if a := b.app.Config.K9s.Activity; a.IsEnabled() {
+		b.activity = model.NewActivity(a.WindowDuration())
+	}
+	b.GetTable().SetDecorateFn(b.activityDecorator(b.healthDecorator(b.noteDecorator(b.GetTable().DecorateFn()))))
+	b.GetTable().SetSelectedRowFn(b.rowSelected)
 
 	b.bindKeys(b.Actions())
 	for _, f := range b.bindKeysFn {
@@ -398,6 +407,31 @@ func (b *Browser) viewCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (b *Browser) cpIdentityCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := b.GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	o, err := b.app.factory.Get(b.GVR(), path, true, labels.Everything())
+	if err != nil {
+		b.app.Flash().Err(err)
+		return nil
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		b.app.Flash().Errf("expecting unstructured but got %T", o)
+		return nil
+	}
+	if err := clipboardWrite(dao.Identity(u, b.app.Config.ActiveContextName())); err != nil {
+		b.app.Flash().Err(err)
+		return nil
+	}
+	b.app.Flash().Info("Resource identity copied to clipboard...")
+
+	return nil
+}
+
 func (b *Browser) helpCmd(evt *tcell.EventKey) *tcell.EventKey {
 	if b.CmdBuff().InCmdMode() {
 		return nil
@@ -511,6 +545,83 @@ func (b *Browser) editCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (b *Browser) editFollowCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := b.GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	b.Stop()
+	defer b.Start()
+	if err := editRes(b.app, b.GVR(), path); err != nil {
+		b.App().Flash().Err(err)
+		return nil
+	}
+	followResource(b.app, b.GVR(), path)
+
+	return nil
+}
+
+func (b *Browser) notifyCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := b.GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	ShowCondition(b, path)
+
+	return nil
+}
+
+func (b *Browser) noteCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := b.GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	ShowNote(b, path)
+
+	return nil
+}
+
+func (b *Browser) reconcileCmd(evt *tcell.EventKey) *tcell.EventKey {
+	paths := b.GetSelectedItems()
+	if len(paths) == 0 || paths[0] == "" {
+		return evt
+	}
+	ShowReconcile(b, paths)
+
+	return nil
+}
+
+func (b *Browser) finalizersCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := b.GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	ShowFinalizers(b, path)
+
+	return nil
+}
+
+func (b *Browser) snapshotCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := b.GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	ShowSnapshots(b, path)
+
+	return nil
+}
+
+func (b *Browser) diffCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := b.GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	ShowDiffFile(b, path)
+
+	return nil
+}
+
 func editRes(app *App, gvr *client.GVR, path string) error {
 	if path == "" {
 		return fmt.Errorf("nothing selected %q", path)
@@ -531,7 +642,9 @@ func editRes(app *App, gvr *client.GVR, path string) error {
 	if ns != client.BlankNamespace {
 		args = append(args, "-n", ns)
 	}
-	if err := runK(app, &shellOpts{clear: true, args: args}); err != nil {
+	err := runK(app, &shellOpts{clear: true, args: args})
+	app.audit(config.AuditEdit, gvr, path, err)
+	if err != nil {
 		app.Flash().Errf("Edit command failed: %s", err)
 	}
 
@@ -624,6 +737,18 @@ func (b *Browser) refreshActions() {
 						Visible:   true,
 						Dangerous: true,
 					}))
+				aa.Add(ui.KeyShiftE, ui.NewKeyActionWithOpts("Edit Follow", b.editFollowCmd,
+					ui.ActionOpts{
+						Visible:   true,
+						Dangerous: true,
+					}))
+			}
+			if client.Can(b.meta.Verbs, "patch") {
+				aa.Add(tcell.KeyCtrlO, ui.NewKeyActionWithOpts("Reconcile", b.reconcileCmd,
+					ui.ActionOpts{
+						Visible:   true,
+						Dangerous: true,
+					}))
 			}
 			if client.Can(b.meta.Verbs, "delete") {
 				aa.Add(tcell.KeyCtrlD, ui.NewKeyActionWithOpts("Delete", b.deleteCmd,
@@ -639,6 +764,14 @@ func (b *Browser) refreshActions() {
 	if !dao.IsK9sMeta(b.meta) {
 		aa.Add(ui.KeyY, ui.NewKeyAction(yamlAction, b.viewCmd, true))
 		aa.Add(ui.KeyD, ui.NewKeyAction("Describe", b.describeCmd, true))
+		if b.app.ConOK() {
+			aa.Add(ui.KeyShiftW, ui.NewKeyAction("Notify When", b.notifyCmd, true))
+			aa.Add(ui.KeyShiftY, ui.NewKeyAction("Diff File", b.diffCmd, true))
+			aa.Add(ui.KeyShiftG, ui.NewKeyAction("Copy Identity", b.cpIdentityCmd, false))
+			aa.Add(ui.KeyShiftH, ui.NewKeyAction("Note", b.noteCmd, true))
+			aa.Add(tcell.KeyCtrlN, ui.NewKeyAction("Snapshot", b.snapshotCmd, true))
+			aa.Add(ui.KeyShiftQ, ui.NewKeyAction("Finalizers", b.finalizersCmd, true))
+		}
 	}
 	for _, f := range b.bindKeysFn {
 		f(aa)
@@ -707,7 +840,9 @@ func (b *Browser) simpleDelete(selections []string, msg string) {
 				b.app.Flash().Errf("Invalid nuker %T", b.accessor)
 				continue
 			}
-			if err := nuker.Delete(context.Background(), sel, nil, dao.DefaultGrace); err != nil {
+			err := nuker.Delete(context.Background(), sel, nil, dao.DefaultGrace)
+			b.app.audit(config.AuditDelete, b.GVR(), sel, err)
+			if err != nil {
 				b.app.Flash().Errf("Delete failed with `%s", err)
 			} else {
 				b.app.factory.DeleteForwarder(sel)
@@ -731,7 +866,9 @@ func (b *Browser) resourceDelete(selections []string, msg string) {
 			if force {
 				grace = dao.ForceGrace
 			}
-			if err := b.GetModel().Delete(b.defaultContext(), sel, propagation, grace); err != nil {
+			err := b.GetModel().Delete(b.defaultContext(), sel, propagation, grace)
+			b.app.audit(config.AuditDelete, b.GVR(), sel, err)
+			if err != nil {
 				b.app.Flash().Errf("Delete failed with `%s", err)
 			} else {
 				b.app.factory.DeleteForwarder(sel)
@@ -743,3 +880,200 @@ func (b *Browser) resourceDelete(selections []string, msg string) {
 	d := b.app.Styles.Dialog()
 	dialog.ShowDelete(&d, b.app.Content.Pages, msg, okFn, func() {})
 }
+
+// noteDecorator flags rows with a local note, chaining any existing decorator.
+func (b *Browser) noteDecorator(f ui.DecorateFunc) ui.DecorateFunc {
+	return func(data *model1.TableData) {
+		if f != nil {
+			f(data)
+		}
+		nn := b.app.notes.For(b.GVR().String())
+		if len(nn) == 0 {
+			return
+		}
+		idx, ok := data.IndexOfHeader("NAME")
+		if !ok {
+			return
+		}
+		data.RowsRange(func(_ int, re model1.RowEvent) bool {
+			if _, ok := nn[re.Row.ID]; ok && idx < len(re.Row.Fields) {
+				re.Row.Fields[idx] += noteIndicator
+			}
+			return true
+		})
+	}
+}
+
+func (b *Browser) rowSelected(r int) {
+	if !b.showNote(r) {
+		b.showHealth(r)
+	}
+}
+
+func (b *Browser) showNote(r int) bool {
+	id, ok := b.GetRowID(r)
+	if !ok {
+		return false
+	}
+	note, ok := b.app.notes.Get(b.GVR().String(), id)
+	if ok {
+		b.app.Flash().Infof("Note: %s", note)
+	}
+
+	return ok
+}
+
+const (
+	healthCol         = "HEALTH"
+	activityIndicator = " ↻"
+)
+
+// activityDecorator flags rows updated recently, chaining any existing decorator.
+func (b *Browser) activityDecorator(f ui.DecorateFunc) ui.DecorateFunc {
+	return func(data *model1.TableData) {
+		if f != nil {
+			f(data)
+		}
+		if b.activity == nil || !dao.IsK8sMeta(b.meta) {
+			return
+		}
+		idx, ok := data.IndexOfHeader("NAME")
+		if !ok {
+			return
+		}
+		rr := make(map[string]model.Revision, data.RowCount())
+		data.RowsRange(func(_ int, re model1.RowEvent) bool {
+			if r, ok := b.revisionOf(re.Row.ID); ok {
+				rr[re.Row.ID] = r
+			}
+			return true
+		})
+		recent := b.activity.Track(rr, time.Now())
+		if recent.Len() == 0 {
+			return
+		}
+		data.RowsRange(func(_ int, re model1.RowEvent) bool {
+			if recent.Has(re.Row.ID) && idx < len(re.Row.Fields) {
+				re.Row.Fields[idx] += activityIndicator
+			}
+			return true
+		})
+	}
+}
+
+func (b *Browser) revisionOf(path string) (model.Revision, bool) {
+	o, err := b.app.factory.Get(b.GVR(), path, false, labels.Everything())
+	if err != nil {
+		return model.Revision{}, false
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return model.Revision{}, false
+	}
+	r := model.Revision{Version: u.GetResourceVersion()}
+	for _, m := range u.GetManagedFields() {
+		if m.Time != nil && m.Time.After(r.Updated) {
+			r.Updated = m.Time.Time
+		}
+	}
+
+	return r, true
+}
+
+// healthDecorator appends a health score column when enabled, chaining any existing decorator.
+func (b *Browser) healthDecorator(f ui.DecorateFunc) ui.DecorateFunc {
+	return func(data *model1.TableData) {
+		if f != nil {
+			f(data)
+		}
+		if !b.app.Config.K9s.Health.IsEnabled() || !dao.IsK8sMeta(b.meta) {
+			b.setVerdicts(nil)
+			return
+		}
+		if _, ok := data.IndexOfHeader(healthCol); ok {
+			return
+		}
+		h := append(data.Header().Clone(), model1.HeaderColumn{
+			Name:  healthCol,
+			Attrs: model1.Attrs{Decorator: healthDot(b.app.Styles.Frame().Status)},
+		})
+		data.SetHeader(data.GetNamespace(), h)
+		rule, ok := b.app.Config.K9s.Health.RuleFor(b.GVR().String())
+		ww := b.warningEvents(rule, data.GetNamespace())
+		vv := make(map[string]health.Verdict, data.RowCount())
+		data.RowsRange(func(i int, re model1.RowEvent) bool {
+			v := health.Verdict{}
+			if ok {
+				v = b.healthOf(re.Row.ID, rule, ww)
+				vv[re.Row.ID] = v
+			}
+			ff := make([]string, 0, len(re.Row.Fields)+1)
+			re.Row.Fields = append(append(ff, re.Row.Fields...), v.Score.String())
+			data.SetRow(i, re)
+			return true
+		})
+		b.setVerdicts(vv)
+	}
+}
+
+func (b *Browser) setVerdicts(vv map[string]health.Verdict) {
+	b.mx.Lock()
+	defer b.mx.Unlock()
+	b.verdicts = vv
+}
+
+// showHealth flashes the last computed health verdict of a selected row if not healthy.
+func (b *Browser) showHealth(r int) {
+	id, ok := b.GetRowID(r)
+	if !ok {
+		return
+	}
+	b.mx.RLock()
+	v, ok := b.verdicts[id]
+	b.mx.RUnlock()
+	if ok && v.Score > health.Healthy {
+		b.app.Flash().Warnf("Health %s: %s", v.Score, v.Reason)
+	}
+}
+
+func (b *Browser) healthOf(path string, rule config.HealthRule, ww map[string]int) health.Verdict {
+	o, err := b.app.factory.Get(b.GVR(), path, false, labels.Everything())
+	if err != nil {
+		return health.Verdict{Reason: err.Error()}
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return health.Verdict{}
+	}
+
+	return health.Evaluate(rule, u.Object, ww[string(u.GetUID())], time.Now())
+}
+
+func (b *Browser) warningEvents(rule config.HealthRule, ns string) map[string]int {
+	if rule.EventsWarn <= 0 && rule.EventsCrit <= 0 {
+		return nil
+	}
+	oo, err := b.app.factory.List(client.EvGVR, client.CleanseNamespace(ns), false, labels.Everything())
+	if err != nil {
+		slog.Warn("Unable to list events for health scoring", slogs.Error, err)
+		return nil
+	}
+
+	return health.WarningEvents(oo)
+}
+
+// healthDot returns a health score decorator colored by the skin status colors.
+func healthDot(st config.Status) model1.DecoratorFunc {
+	return func(s string) string {
+		switch s {
+		case health.Healthy.String():
+			return "[" + st.ModifyColor.String() + "::]●[-::]"
+		case health.Degraded.String():
+			return "[" + st.PendingColor.String() + "::]●[-::]"
+		case health.Failing.String():
+			return "[" + st.ErrorColor.String() + "::]●[-::]"
+		default:
+			return "[" + st.CompletedColor.String() + "::]○[-::]"
+		}
+	}
+}
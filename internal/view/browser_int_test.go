@@ -0,0 +1,48 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/health"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHealthDot(t *testing.T) {
+	st := config.Status{
+		ModifyColor:    "#00ff00",
+		PendingColor:   "#ffff00",
+		ErrorColor:     "#ff0000",
+		CompletedColor: "#808080",
+	}
+	uu := map[string]struct {
+		s, e string
+	}{
+		"healthy": {
+			s: health.Healthy.String(),
+			e: "[#00ff00::]●[-::]",
+		},
+		"degraded": {
+			s: health.Degraded.String(),
+			e: "[#ffff00::]●[-::]",
+		},
+		"failing": {
+			s: health.Failing.String(),
+			e: "[#ff0000::]●[-::]",
+		},
+		"unknown": {
+			s: "",
+			e: "[#808080::]○[-::]",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, healthDot(st)(u.s))
+		})
+	}
+}
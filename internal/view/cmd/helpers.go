@@ -76,6 +76,13 @@ func SuggestSubCommand(command string, namespaces client.NamespaceNames, context
 		}
 		suggests = completeNS(ns, namespaces)
 
+	case p.IsFleetCmd():
+		_, ns, ok := p.FleetArgs()
+		if !ok || ns == "" {
+			return nil
+		}
+		suggests = completeNS(ns, namespaces)
+
 	case p.IsContextCmd():
 		n, ok := p.ContextArg()
 		if !ok {
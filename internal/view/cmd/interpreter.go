@@ -190,6 +190,11 @@ func (c *Interpreter) IsXrayCmd() bool {
 	return xrayCmd.Has(c.cmd)
 }
 
+// IsFleetCmd returns true if fleet cmd is detected.
+func (c *Interpreter) IsFleetCmd() bool {
+	return fleetCmd.Has(c.cmd)
+}
+
 // IsContextCmd returns true if context cmd is detected.
 func (c *Interpreter) IsContextCmd() bool {
 	return contextCmd.Has(c.cmd)
@@ -263,6 +268,20 @@ func (c *Interpreter) XrayArgs() (cmd, namespace string, ok bool) {
 	if !c.IsXrayCmd() {
 		return
 	}
+
+	return c.topicArgs()
+}
+
+// FleetArgs return the gvr and ns if any.
+func (c *Interpreter) FleetArgs() (cmd, namespace string, ok bool) {
+	if !c.IsFleetCmd() {
+		return
+	}
+
+	return c.topicArgs()
+}
+
+func (c *Interpreter) topicArgs() (cmd, namespace string, ok bool) {
 	gvr, ok1 := c.args[topicKey]
 	if !ok1 {
 		return
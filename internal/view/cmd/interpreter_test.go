@@ -361,6 +361,50 @@ func TestXRayCmd(t *testing.T) {
 	}
 }
 
+func TestFleetCmd(t *testing.T) {
+	uu := map[string]struct {
+		cmd     string
+		ok      bool
+		res, ns string
+	}{
+		"empty": {},
+
+		"happy": {
+			cmd: "fleet no",
+			ok:  true,
+			res: "no",
+		},
+
+		"happy+ns": {
+			cmd: "fl po ns1",
+			ok:  true,
+			res: "po",
+			ns:  "ns1",
+		},
+
+		"toast": {
+			cmd: "xray po",
+		},
+
+		"toast-1": {
+			cmd: "fleet",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p := cmd.NewInterpreter(u.cmd)
+			res, ns, ok := p.FleetArgs()
+			assert.Equal(t, u.ok, ok)
+			if u.ok {
+				assert.Equal(t, u.res, res)
+				assert.Equal(t, u.ns, ns)
+			}
+		})
+	}
+}
+
 func TestDirCmd(t *testing.T) {
 	uu := map[string]struct {
 		cmd string
@@ -74,4 +74,8 @@ var (
 		"xr",
 		"xray",
 	)
+	fleetCmd = sets.New(
+		"fl",
+		"fleet",
+	)
 )
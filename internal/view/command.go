@@ -134,6 +134,27 @@ func (c *Command) aliasCmd(p *cmd.Interpreter, pushCmd bool) error {
 	return c.exec(p, client.AliGVR, v, false, pushCmd)
 }
 
+func (c *Command) fleetCmd(p *cmd.Interpreter, pushCmd bool) error {
+	arg, cns, ok := p.FleetArgs()
+	if !ok {
+		return errors.New("invalid command. use `fleet xxx`")
+	}
+	gvr, ok := c.alias.Resolve(cmd.NewInterpreter(arg))
+	if !ok {
+		return fmt.Errorf("invalid resource name: %q", arg)
+	}
+	if meta, err := dao.MetaAccess.MetaFor(gvr); err != nil || !dao.IsK8sMeta(meta) {
+		return fmt.Errorf("unsupported resource %q", arg)
+	}
+	if cns != "" {
+		if err := c.app.switchNS(cns); err != nil {
+			return err
+		}
+	}
+
+	return c.exec(p, client.FlGVR, NewFleet(gvr), true, pushCmd)
+}
+
 func (c *Command) xrayCmd(p *cmd.Interpreter, pushCmd bool) error {
 	arg, cns, ok := p.XrayArgs()
 	if !ok {
@@ -275,6 +296,10 @@ func (c *Command) specialCmd(p *cmd.Interpreter, pushCmd bool) bool {
 		if err := c.xrayCmd(p, pushCmd); err != nil {
 			c.app.Flash().Err(err)
 		}
+	case p.IsFleetCmd():
+		if err := c.fleetCmd(p, pushCmd); err != nil {
+			c.app.Flash().Err(err)
+		}
 	case p.IsRBACCmd():
 		if cat, sub, ok := p.RBACArgs(); !ok {
 			c.app.Flash().Errf("Invalid command. Use `can [u|g|s]:xxx`")
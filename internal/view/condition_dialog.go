@@ -0,0 +1,92 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+)
+
+const conditionKey = "condition"
+
+// ShowCondition pops a watched condition dialog.
+func ShowCondition(view ResourceViewer, path string) {
+	var (
+		app        = view.App()
+		styles     = app.Styles.Dialog()
+		ns, n      = client.Namespaced(path)
+		expr       = model.ReadyCondition
+		anyInScope bool
+	)
+
+	f := tview.NewForm().
+		SetItemPadding(0).
+		SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(styles.ButtonBgColor.Color()).
+		SetButtonTextColor(styles.ButtonFgColor.Color()).
+		SetLabelColor(styles.LabelFgColor.Color()).
+		SetFieldTextColor(styles.FieldFgColor.Color()).
+		SetFieldBackgroundColor(styles.BgColor.Color())
+
+	f.AddInputField("Condition:", expr, 0, nil, func(v string) {
+		expr = v
+	})
+	f.AddCheckbox("Any in namespace:", anyInScope, func(_ string, v bool) {
+		anyInScope = v
+	})
+
+	pages := app.Content.Pages
+	f.AddButton("Cancel", func() {
+		DismissCondition(view, pages)
+	})
+	f.AddButton("Clear All", func() {
+		DismissCondition(view, pages)
+		app.conditions.Clear()
+		app.Flash().Info("All watched conditions cleared")
+	})
+	f.AddButton("OK", func() {
+		name := n
+		if anyInScope {
+			name = ""
+		}
+		c, err := model.NewCondition(view.GVR(), ns, name, expr)
+		if err != nil {
+			app.Flash().Err(err)
+			return
+		}
+		DismissCondition(view, pages)
+		app.conditions.Add(c)
+		app.Flash().Infof("Watching condition %s", c)
+	})
+	for i := range 3 {
+		if b := f.GetButton(i); b != nil {
+			b.SetBackgroundColorActivated(styles.ButtonFocusBgColor.Color())
+			b.SetLabelColorActivated(styles.ButtonFocusFgColor.Color())
+		}
+	}
+
+	modal := tview.NewModalForm("<Notify>", f)
+	msg := fmt.Sprintf("Notify when %s %s matches", view.GVR().R(), path)
+	if cc := app.conditions.List(); len(cc) > 0 {
+		msg += fmt.Sprintf(" (%d active)", len(cc))
+	}
+	modal.SetText(msg + "?")
+	modal.SetDoneFunc(func(int, string) {
+		DismissCondition(view, pages)
+	})
+
+	pages.AddPage(conditionKey, modal, false, true)
+	pages.ShowPage(conditionKey)
+	app.SetFocus(pages.GetPrimitive(conditionKey))
+}
+
+// DismissCondition dismiss the condition dialog.
+func DismissCondition(v ResourceViewer, p *ui.Pages) {
+	p.RemovePage(conditionKey)
+	v.App().SetFocus(p.CurrentPage().Item)
+}
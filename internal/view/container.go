@@ -16,6 +16,7 @@ import (
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/tcell/v2"
+	"gopkg.in/yaml.v3"
 	v1 "k8s.io/api/core/v1"
 )
 
@@ -92,6 +93,7 @@ func (c *Container) bindKeys(aa *ui.KeyActions) {
 		ui.KeyShiftF: ui.NewKeyAction("PortForward", c.portFwdCmd, true),
 		ui.KeyShiftT: ui.NewKeyAction("Sort Restart", c.GetTable().SortColCmd("RESTARTS", false), false),
 		ui.KeyShiftI: ui.NewKeyAction("Sort Idx", c.GetTable().SortColCmd("IDX", true), false),
+		ui.KeyO:      ui.NewKeyAction("Show Command", c.showCommandCmd, true),
 	})
 	aa.Merge(resourceSorters(c.GetTable()))
 }
@@ -150,6 +152,52 @@ func (c *Container) showPFCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+// containerCommand tracks what a container runs untruncated.
+type containerCommand struct {
+	Entrypoint string   `yaml:"entrypoint"`
+	Command    []string `yaml:"command,omitempty"`
+	Args       []string `yaml:"args,omitempty"`
+	WorkingDir string   `yaml:"workingDir,omitempty"`
+}
+
+func newContainerCommand(co *v1.Container) containerCommand {
+	return containerCommand{
+		Entrypoint: render.ToEntrypoint(co),
+		Command:    co.Command,
+		Args:       co.Args,
+		WorkingDir: co.WorkingDir,
+	}
+}
+
+func (c *Container) showCommandCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := c.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	po, err := fetchPod(c.App().factory, c.GetTable().Path)
+	if err != nil {
+		c.App().Flash().Err(err)
+		return nil
+	}
+	co, err := locateContainer(path, append(po.Spec.InitContainers, po.Spec.Containers...))
+	if err != nil {
+		c.App().Flash().Err(err)
+		return nil
+	}
+	raw, err := yaml.Marshal(newContainerCommand(co))
+	if err != nil {
+		c.App().Flash().Err(err)
+		return nil
+	}
+	details := NewDetails(c.App(), "Command", c.GetTable().Path+":"+path, contentYAML, true).Update(string(raw))
+	if err := c.App().inject(details, false); err != nil {
+		c.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
 func (c *Container) portForwardContext(ctx context.Context) context.Context {
 	if bc := c.App().BenchFile; bc != "" {
 		ctx = context.WithValue(ctx, internal.KeyBenchCfg, c.App().BenchFile)
@@ -0,0 +1,44 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+	v1 "k8s.io/api/core/v1"
+)
+
+func TestNewContainerCommand(t *testing.T) {
+	long := strings.Repeat("a", 100)
+	uu := map[string]struct {
+		co v1.Container
+		e  string
+	}{
+		"image": {
+			e: "entrypoint: image\n",
+		},
+		"args": {
+			co: v1.Container{Args: []string{"--flag", long}},
+			e:  "entrypoint: " + render.EntrypointArgsOverride + "\nargs:\n    - --flag\n    - " + long + "\n",
+		},
+		"override": {
+			co: v1.Container{Command: []string{"sh", "-c"}, Args: []string{"echo hi"}, WorkingDir: "/app"},
+			e:  "entrypoint: override\ncommand:\n    - sh\n    - -c\nargs:\n    - echo hi\nworkingDir: /app\n",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			raw, err := yaml.Marshal(newContainerCommand(&u.co))
+			require.NoError(t, err)
+			assert.Equal(t, u.e, string(raw))
+		})
+	}
+}
@@ -0,0 +1,88 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/k9s/internal/view/cmd"
+	"github.com/derailed/tcell/v2"
+)
+
+const activeContextMarker = "(active)"
+
+func (a *App) quickSwitchCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if a.InCmdMode() {
+		return evt
+	}
+	if a.Conn() == nil || a.Conn().Config() == nil {
+		a.Flash().Err(fmt.Errorf("no connection available"))
+		return nil
+	}
+	cc, err := a.Conn().Config().Contexts()
+	if err != nil {
+		a.Flash().Err(err)
+		return nil
+	}
+	names := make([]string, 0, len(cc))
+	for n := range cc {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+
+	active := a.Config.ActiveContextName()
+	options := make([]string, 0, len(names))
+	for _, n := range names {
+		o := fmt.Sprintf("%s (%s@%s)", n, cc[n].AuthInfo, cc[n].Cluster)
+		if n == active {
+			o += " " + activeContextMarker
+		}
+		options = append(options, o)
+	}
+
+	d := a.Styles.Dialog()
+	dialog.ShowSelection(&d, a.Content.Pages, "Switch Context", options, func(i int) {
+		if i < 0 || i >= len(names) {
+			return
+		}
+		if err := a.quickSwitchContext(names[i]); err != nil {
+			a.Flash().Err(err)
+		}
+	})
+
+	return nil
+}
+
+// quickSwitchContext switches context in place, keeping the current view and namespace
+// when they are available in the target context.
+func (a *App) quickSwitchContext(name string) error {
+	if name == a.Config.ActiveContextName() {
+		return nil
+	}
+	p := cmd.NewInterpreter(a.Config.ActiveView())
+	p.ResetContextArg()
+	ns := a.Config.ActiveNamespace()
+	if cns, ok := p.NSArg(); ok {
+		ns = cns
+	}
+	if err := useContext(a, name); err != nil {
+		return err
+	}
+	if p.IsContextCmd() {
+		return nil
+	}
+	if a.Conn().IsValidNamespace(ns) {
+		p.SwitchNS(ns)
+	} else {
+		p.ClearNS()
+		a.Flash().Warnf("Namespace %q not found in context %q. Using %q", ns, name, a.Config.ActiveNamespace())
+	}
+	if err := a.command.run(p, "", true, true); err != nil {
+		return fmt.Errorf("view %q unavailable in context %q: %w", p.Cmd(), name, err)
+	}
+
+	return nil
+}
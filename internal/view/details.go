@@ -23,6 +23,7 @@ const (
 	detailsTitleFmt = "[fg:bg:b] %s([hilite:bg:b]%s[fg:bg:-])[fg:bg:-] "
 	contentTXT      = "text"
 	contentYAML     = "yaml"
+	contentDiff     = "diff"
 )
 
 // Details represents a generic text viewer.
@@ -101,6 +102,8 @@ func (d *Details) TextChanged(lines []string) {
 	switch d.contentType {
 	case contentYAML:
 		d.text.SetText(colorizeYAML(d.app.Styles.Views().Yaml, strings.Join(lines, "\n")))
+	case contentDiff:
+		d.text.SetText(colorizeDiff(d.app.Styles.Views().Diff, strings.Join(lines, "\n")))
 	default:
 		d.text.SetText(strings.Join(lines, "\n"))
 	}
@@ -112,7 +115,11 @@ func (d *Details) TextFiltered(lines []string, matches fuzzy.Matches) {
 	d.currentRegion, d.maxRegions = 0, len(matches)
 	ll := linesWithRegions(lines, matches)
 
-	d.text.SetText(colorizeYAML(d.app.Styles.Views().Yaml, strings.Join(ll, "\n")))
+	if d.contentType == contentDiff {
+		d.text.SetText(colorizeDiff(d.app.Styles.Views().Diff, strings.Join(ll, "\n")))
+	} else {
+		d.text.SetText(colorizeYAML(d.app.Styles.Views().Yaml, strings.Join(ll, "\n")))
+	}
 	d.text.Highlight()
 	if len(matches) > 0 {
 		d.text.Highlight("search_0")
@@ -0,0 +1,115 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+)
+
+const diffKey = "diff"
+
+// lastDiffFile tracks the last manifest used for diffs during the session.
+var lastDiffFile string
+
+// ShowDiffFile pops a dialog to diff a live resource against a local manifest.
+func ShowDiffFile(view ResourceViewer, path string) {
+	var (
+		app      = view.App()
+		styles   = app.Styles.Dialog()
+		manifest = lastDiffFile
+	)
+
+	f := tview.NewForm().
+		SetItemPadding(0).
+		SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(styles.ButtonBgColor.Color()).
+		SetButtonTextColor(styles.ButtonFgColor.Color()).
+		SetLabelColor(styles.LabelFgColor.Color()).
+		SetFieldTextColor(styles.FieldFgColor.Color()).
+		SetFieldBackgroundColor(styles.BgColor.Color())
+
+	f.AddInputField("Manifest:", manifest, 0, nil, func(v string) {
+		manifest = strings.TrimSpace(v)
+	})
+
+	pages := app.Content.Pages
+	f.AddButton("Cancel", func() {
+		DismissDiffFile(view, pages)
+	})
+	f.AddButton("OK", func() {
+		DismissDiffFile(view, pages)
+		lastDiffFile = manifest
+		if err := diffFile(app, view, path, manifest); err != nil {
+			app.Flash().Err(err)
+		}
+	})
+	for i := range 2 {
+		if b := f.GetButton(i); b != nil {
+			b.SetBackgroundColorActivated(styles.ButtonFocusBgColor.Color())
+			b.SetLabelColorActivated(styles.ButtonFocusFgColor.Color())
+		}
+	}
+
+	modal := tview.NewModalForm("<Diff>", f)
+	modal.SetText(fmt.Sprintf("Diff %s %s against manifest?", view.GVR().R(), path))
+	modal.SetDoneFunc(func(int, string) {
+		DismissDiffFile(view, pages)
+	})
+
+	pages.AddPage(diffKey, modal, false, true)
+	pages.ShowPage(diffKey)
+	app.SetFocus(pages.GetPrimitive(diffKey))
+}
+
+// DismissDiffFile dismiss the diff dialog.
+func DismissDiffFile(v ResourceViewer, p *ui.Pages) {
+	p.RemovePage(diffKey)
+	v.App().SetFocus(p.CurrentPage().Item)
+}
+
+func diffFile(app *App, view ResourceViewer, path, manifest string) error {
+	if manifest == "" {
+		return errors.New("no manifest specified")
+	}
+	live, err := fetchLive(app, view, path)
+	if err != nil {
+		return err
+	}
+	res, err := dao.DiffManifest(live, manifest)
+	if err != nil {
+		return err
+	}
+	if res == "" {
+		app.Flash().Infof("No differences found between %s and %s", path, manifest)
+		return nil
+	}
+	details := NewDetails(app, "Diff", path, contentDiff, true).Update(res)
+
+	return app.inject(details, false)
+}
+
+func colorizeDiff(style config.Diff, raw string) string {
+	lines := strings.Split(tview.Escape(raw), "\n")
+	buff := make([]string, 0, len(lines))
+	for _, l := range lines {
+		switch {
+		case strings.HasPrefix(l, "+++"), strings.HasPrefix(l, "---"), strings.HasPrefix(l, "@@"):
+			l = "[" + style.HunkColor.String() + "::b]" + l + "[-::-]"
+		case strings.HasPrefix(l, "+"):
+			l = "[" + style.AddColor.String() + "::]" + l + "[-::]"
+		case strings.HasPrefix(l, "-"):
+			l = "[" + style.DeleteColor.String() + "::]" + l + "[-::]"
+		}
+		buff = append(buff, enableRegion(l))
+	}
+
+	return strings.Join(buff, "\n")
+}
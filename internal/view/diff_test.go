@@ -0,0 +1,46 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestColorizeDiff(t *testing.T) {
+	style := config.Diff{
+		AddColor:    "#00ff00",
+		DeleteColor: "#ff0000",
+		HunkColor:   "#00ffff",
+	}
+	uu := map[string]struct {
+		s, e string
+	}{
+		"header": {
+			s: "--- live\n+++ cm.yaml",
+			e: "[#00ffff::b]--- live[-::-]\n[#00ffff::b]+++ cm.yaml[-::-]",
+		},
+		"hunk": {
+			s: "@@ -1,2 +1,2 @@",
+			e: "[#00ffff::b]@@ -1,2 +1,2 @@[-::-]",
+		},
+		"changes": {
+			s: " data:\n-  a: \"1\"\n+  a: \"2\"",
+			e: " data:\n[#ff0000::]-  a: \"1\"[-::]\n[#00ff00::]+  a: \"2\"[-::]",
+		},
+		"escaped": {
+			s: "+  a: [fred]",
+			e: "[#00ff00::]+  a: [fred[][-::]",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, colorizeDiff(style, u.s))
+		})
+	}
+}
@@ -13,6 +13,7 @@ import (
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/k9s/internal/ui/dialog"
 	"github.com/derailed/tcell/v2"
@@ -66,6 +67,10 @@ func (d *Dir) bindDangerousKeys(aa *ui.KeyActions) {
 			Visible:   true,
 			Dangerous: true,
 		}),
+		ui.KeyShiftA: ui.NewKeyActionWithOpts("Apply Follow", d.applyFollowCmd, ui.ActionOpts{
+			Visible:   true,
+			Dangerous: true,
+		}),
 		ui.KeyD: ui.NewKeyActionWithOpts("Delete", d.delCmd, ui.ActionOpts{
 			Visible:   true,
 			Dangerous: true,
@@ -205,21 +210,10 @@ func (d *Dir) applyCmd(evt *tcell.EventKey) *tcell.EventKey {
 		return evt
 	}
 
-	opts := []string{"-f"}
-	if containsDir(sel) {
-		opts = append(opts, "-R")
-	}
-	if isKustomized(sel) {
-		opts = []string{"-k"}
-	}
 	d.Stop()
 	defer d.Start()
 	{
-		args := make([]string, 0, 10)
-		args = append(args, "apply")
-		args = append(args, opts...)
-		args = append(args, sel)
-		res, err := runKu(context.Background(), d.App(), &shellOpts{clear: false, args: args})
+		res, err := d.apply(sel)
 		if err != nil {
 			res = "status:\n  " + err.Error() + "\nmessage:\n" + fmtResults(res)
 		} else {
@@ -235,6 +229,48 @@ func (d *Dir) applyCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (d *Dir) applyFollowCmd(evt *tcell.EventKey) *tcell.EventKey {
+	sel := d.GetTable().GetSelectedItem()
+	if sel == "" {
+		return evt
+	}
+	if !isManifest(sel) {
+		d.App().Flash().Errf("you must select a manifest to follow")
+		return nil
+	}
+
+	d.Stop()
+	defer d.Start()
+	if res, err := d.apply(sel); err != nil {
+		d.App().Flash().Errf("Apply failed: %s %s", err, strings.TrimSpace(res))
+		return nil
+	}
+	if err := followManifest(d.App(), sel); err != nil {
+		d.App().Flash().Errf("Follow failed: %s", err)
+	}
+
+	return nil
+}
+
+func (d *Dir) apply(sel string) (string, error) {
+	opts := []string{"-f"}
+	if containsDir(sel) {
+		opts = append(opts, "-R")
+	}
+	if isKustomized(sel) {
+		opts = []string{"-k"}
+	}
+	args := make([]string, 0, 10)
+	args = append(args, "apply")
+	args = append(args, opts...)
+	args = append(args, sel)
+
+	res, err := runKu(context.Background(), d.App(), &shellOpts{clear: false, args: args})
+	d.App().audit(config.AuditApply, d.GVR(), sel, err)
+
+	return res, err
+}
+
 func (d *Dir) delCmd(evt *tcell.EventKey) *tcell.EventKey {
 	sel := d.GetTable().GetSelectedItem()
 	if sel == "" {
@@ -261,6 +297,7 @@ func (d *Dir) delCmd(evt *tcell.EventKey) *tcell.EventKey {
 		args = append(args, opts...)
 		args = append(args, sel)
 		res, err := runKu(context.Background(), d.App(), &shellOpts{clear: false, args: args})
+		d.App().audit(config.AuditDelete, d.GVR(), sel, err)
 		if err != nil {
 			res = "status:\n  " + err.Error() + "\nmessage:\n" + fmtResults(res)
 		} else {
@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// ShowFinalizers lists a resource finalizers and offers to remove one when the resource is terminating.
+func ShowFinalizers(view ResourceViewer, path string) {
+	app := view.App()
+	o, err := app.factory.Get(view.GVR(), path, true, labels.Everything())
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		app.Flash().Errf("expecting unstructured but got %T", o)
+		return
+	}
+	ff := u.GetFinalizers()
+	if len(ff) == 0 {
+		app.Flash().Infof("No finalizers on %s", path)
+		return
+	}
+	if u.GetDeletionTimestamp() == nil || app.Config.IsReadOnly() {
+		app.Flash().Infof("Finalizers on %s: %s", path, strings.Join(ff, ", "))
+		return
+	}
+
+	d := app.Styles.Dialog()
+	dialog.ShowSelection(&d, app.Content.Pages, "Remove Finalizer", ff, func(i int) {
+		if i < 0 || i >= len(ff) {
+			return
+		}
+		confirmFinalizer(view, path, ff, ff[i])
+	})
+}
+
+func confirmFinalizer(view ResourceViewer, path string, ff []string, finalizer string) {
+	app := view.App()
+	msg := fmt.Sprintf(
+		"Removing finalizer [orange::b]%s[-::-] from %s skips its cleanup and may orphan external resources!\nPlease enter [orange::b]%s[-::-] to proceed.",
+		finalizer,
+		path,
+		magicPrompt,
+	)
+	dialog.ShowConfirmAck(app.App, app.Content.Pages, magicPrompt, true, "Remove Finalizer", msg, func() {
+		err := dao.RemoveFinalizer(context.Background(), app.factory, view.GVR(), path, ff, finalizer)
+		app.audit(config.AuditFinalizer, view.GVR(), path+" "+finalizer, err)
+		if err != nil {
+			app.Flash().Err(err)
+			return
+		}
+		app.Flash().Infof("Finalizer %s removed from %s", finalizer, path)
+	}, func() {})
+}
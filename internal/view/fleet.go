@@ -0,0 +1,38 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+)
+
+// Fleet presents a resource aggregated across the configured fleet contexts.
+type Fleet struct {
+	ResourceViewer
+
+	target *client.GVR
+}
+
+// NewFleet returns a new viewer for a given resource.
+func NewFleet(gvr *client.GVR) ResourceViewer {
+	f := Fleet{
+		ResourceViewer: NewBrowser(client.FlGVR),
+		target:         gvr,
+	}
+	f.GetTable().SetSortCol("CONTEXT", true)
+	f.SetContextFn(f.fleetContext)
+
+	return &f
+}
+
+func (f *Fleet) fleetContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, internal.KeyFleet, dao.FleetSpec{
+		GVR:      f.target,
+		Contexts: f.App().Config.K9s.Fleet.ContextNames(),
+	})
+}
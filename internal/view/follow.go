@@ -0,0 +1,114 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/slogs"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	followTimeout = 5 * time.Minute
+	followRate    = 2 * time.Second
+)
+
+// followManifest navigates to the first resource declared in a manifest and tracks its status.
+func followManifest(app *App, manifest string) error {
+	oo, err := dao.ReadManifests(manifest)
+	if err != nil {
+		return err
+	}
+	o := oo[0]
+	gv, err := schema.ParseGroupVersion(o.GetAPIVersion())
+	if err != nil {
+		return err
+	}
+	gvr, namespaced, ok := dao.MetaAccess.GVK2GVR(gv, o.GetKind())
+	if !ok {
+		return fmt.Errorf("unsupported GVK: %s/%s", o.GetAPIVersion(), o.GetKind())
+	}
+
+	path := o.GetName()
+	if namespaced {
+		ns := o.GetNamespace()
+		if ns == "" {
+			if ns, err = app.Conn().Config().CurrentNamespaceName(); err != nil || ns == "" {
+				ns = client.DefaultNamespace
+			}
+		}
+		path = client.FQN(ns, path)
+	}
+	app.gotoResource(gvr.String(), path, false, true)
+	followResource(app, gvr, path)
+
+	return nil
+}
+
+// followResource tracks a resource status until it converges, fails, times out
+// or the user navigates away from the current view.
+func followResource(app *App, gvr *client.GVR, path string) {
+	top := app.Content.Top()
+	ctx, cancel := context.WithTimeout(context.Background(), followTimeout)
+	app.Flash().Infof("Following %s %s...", gvr.R(), path)
+
+	go func() {
+		defer cancel()
+		ticker := time.NewTicker(followRate)
+		defer ticker.Stop()
+
+		var last string
+		for {
+			select {
+			case <-ctx.Done():
+				app.Flash().Warnf("Follow timed out after %s for %s %s [%s]", followTimeout, gvr.R(), path, last)
+				return
+			case <-ticker.C:
+			}
+			if app.Content.Top() != top {
+				slog.Debug("Follow canceled", slogs.GVR, gvr, slogs.FQN, path)
+				return
+			}
+			st, msg, err := followStatus(app, gvr, path)
+			if err != nil {
+				slog.Debug("Follow status check failed", slogs.FQN, path, slogs.Error, err)
+				continue
+			}
+			switch st {
+			case dao.StateReady:
+				app.Flash().Infof("%s %s is ready (%s)", gvr.R(), path, msg)
+				return
+			case dao.StateFailed:
+				app.Flash().Errf("%s %s failed: %s", gvr.R(), path, msg)
+				return
+			default:
+				last = msg
+				app.Flash().Infof("Waiting on %s %s: %s", gvr.R(), path, msg)
+			}
+		}
+	}()
+}
+
+func followStatus(app *App, gvr *client.GVR, path string) (dao.ReadyState, string, error) {
+	o, err := app.factory.Get(gvr, path, false, labels.Everything())
+	if err != nil {
+		return dao.StatePending, "", err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return dao.StatePending, "", errors.New("expecting unstructured resource")
+	}
+	st, msg := dao.Readiness(u)
+
+	return st, msg, nil
+}
@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/render/helm"
@@ -122,7 +123,9 @@ func (h *History) rollbackCmd(evt *tcell.EventKey) *tcell.EventKey {
 func (h *History) rollback(ctx context.Context, path, rev string) error {
 	var hm dao.HelmHistory
 	hm.Init(h.App().factory, h.GVR())
-	if err := hm.Rollback(ctx, path, rev); err != nil {
+	err := hm.Rollback(ctx, path, rev)
+	h.App().audit(config.AuditRollback, h.GVR(), path+":"+rev, err)
+	if err != nil {
 		return err
 	}
 	h.Refresh()
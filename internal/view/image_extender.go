@@ -9,6 +9,7 @@ import (
 	"log/slog"
 	"strings"
 
+	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/slogs"
 	"github.com/derailed/k9s/internal/ui"
@@ -129,7 +130,9 @@ func (s *ImageExtender) makeSetImageForm(fqn string) (*tview.Form, error) {
 			}
 			ctx, cancel := context.WithTimeout(context.Background(), s.App().Conn().Config().CallTimeout())
 			defer cancel()
-			if err := s.setImages(ctx, fqn, imageSpecsModified); err != nil {
+			err := s.setImages(ctx, fqn, imageSpecsModified)
+			s.App().audit(config.AuditSetImage, s.GVR(), fqn, err)
+			if err != nil {
 				slog.Error("Unable to set image name",
 					slogs.FQN, fqn,
 					slogs.Error, err,
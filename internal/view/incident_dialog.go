@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"time"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+)
+
+const incidentKey = "incident"
+
+// ShowIncident pops an incident reference time dialog.
+func ShowIncident(app *App) {
+	var (
+		styles = app.Styles.Dialog()
+		at     = time.Now().Format(render.IncidentFormat)
+	)
+	if t, ok := render.IncidentTime(); ok {
+		at = t.Format(render.IncidentFormat)
+	}
+
+	f := tview.NewForm().
+		SetItemPadding(0).
+		SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(styles.ButtonBgColor.Color()).
+		SetButtonTextColor(styles.ButtonFgColor.Color()).
+		SetLabelColor(styles.LabelFgColor.Color()).
+		SetFieldTextColor(styles.FieldFgColor.Color()).
+		SetFieldBackgroundColor(styles.BgColor.Color())
+
+	f.AddInputField("Incident Time:", at, 0, nil, func(v string) {
+		at = v
+	})
+
+	pages := app.Content.Pages
+	f.AddButton("Cancel", func() {
+		DismissIncident(app, pages)
+	})
+	f.AddButton("Off", func() {
+		DismissIncident(app, pages)
+		render.ClearIncidentTime()
+		app.Flash().Info("Incident mode off")
+	})
+	f.AddButton("OK", func() {
+		t, err := render.ParseIncidentTime(at, time.Now())
+		if err != nil {
+			app.Flash().Err(err)
+			return
+		}
+		DismissIncident(app, pages)
+		render.SetIncidentTime(t)
+		app.Flash().Infof("Incident mode on. Ages are relative to %s", t.Format(render.IncidentFormat))
+	})
+	for i := range 3 {
+		if b := f.GetButton(i); b != nil {
+			b.SetBackgroundColorActivated(styles.ButtonFocusBgColor.Color())
+			b.SetLabelColorActivated(styles.ButtonFocusFgColor.Color())
+		}
+	}
+
+	modal := tview.NewModalForm("<Incident>", f)
+	modal.SetText("Render ages relative to (timestamp, HH:MM or duration ago)?")
+	modal.SetDoneFunc(func(int, string) {
+		DismissIncident(app, pages)
+	})
+
+	pages.AddPage(incidentKey, modal, false, true)
+	pages.ShowPage(incidentKey)
+	app.SetFocus(pages.GetPrimitive(incidentKey))
+}
+
+// DismissIncident dismiss the incident dialog.
+func DismissIncident(app *App, p *ui.Pages) {
+	p.RemovePage(incidentKey)
+	app.SetFocus(p.CurrentPage().Item)
+}
@@ -9,6 +9,7 @@ import (
 	"log/slog"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/config"
@@ -18,13 +19,20 @@ import (
 	"github.com/derailed/k9s/internal/view/cmd"
 	"github.com/derailed/tcell/v2"
 	"github.com/derailed/tview"
+	"github.com/pmezard/go-difflib/difflib"
 	"github.com/sahilm/fuzzy"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/labels"
 )
 
 const (
 	liveViewTitleFmt = "[fg:bg:b] %s([hilite:bg:b]%s[fg:bg:-])[fg:bg:-] "
+	liveDeletedFmt   = "[red:bg:b]<deleted>[fg:bg:-] "
+	liveChangeFmt    = "[:%s:]%s[:-:]"
 	yamlAction       = "YAML"
+
+	// changeFlashDelay tracks how long updated lines stay highlighted.
+	changeFlashDelay = 2 * time.Second
 )
 
 // LiveView represents a live text viewer.
@@ -42,6 +50,10 @@ type LiveView struct {
 	fullScreen                bool
 	managedField              bool
 	autoRefresh               bool
+	flashChanges              bool
+	deleted                   bool
+	lines                     []string
+	rev                       int
 }
 
 // NewLiveView returns a live viewer.
@@ -57,6 +69,7 @@ func NewLiveView(app *App, title string, m model.ResourceViewer) *LiveView {
 		cmdBuff:       model.NewFishBuff('/', model.FilterBuffer),
 		model:         m,
 		autoRefresh:   app.Config.K9s.LiveViewAutoRefresh,
+		flashChanges:  true,
 	}
 	v.AddItem(v.text, 0, 1, true)
 
@@ -103,9 +116,29 @@ func (v *LiveView) InCmdMode() bool {
 
 // ResourceFailed notifies when there is an issue.
 func (v *LiveView) ResourceFailed(err error) {
-	v.text.SetTextAlign(tview.AlignCenter)
-	x, _, w, _ := v.GetRect()
-	v.text.SetText(cowTalk(err.Error(), x+w))
+	v.app.QueueUpdateDraw(func() {
+		if apierrors.IsNotFound(err) && len(v.lines) > 0 {
+			v.resourceDeleted()
+			return
+		}
+		v.text.SetTextAlign(tview.AlignCenter)
+		x, _, w, _ := v.GetRect()
+		v.text.SetText(cowTalk(err.Error(), x+w))
+	})
+}
+
+// resourceDeleted keeps the last known revision around once the resource is gone.
+func (v *LiveView) resourceDeleted() {
+	if v.deleted {
+		return
+	}
+	v.deleted = true
+	if v.cancel != nil {
+		v.cancel()
+		v.cancel = nil
+	}
+	v.app.Flash().Warnf("%s was deleted. Showing last known revision", v.model.GetPath())
+	v.updateTitle()
 }
 
 // ResourceChanged notifies when the filter changes.
@@ -118,17 +151,68 @@ func (v *LiveView) ResourceChanged(lines []string, matches fuzzy.Matches) {
 			v.text.ScrollToBeginning()
 		}
 
-		lines = linesWithRegions(lines, matches)
-		v.text.SetText(colorizeYAML(v.app.Styles.Views().Yaml, strings.Join(lines, "\n")))
+		var changed map[int]struct{}
+		if v.flashChanges && v.autoRefresh {
+			changed = changedLines(v.lines, lines)
+		}
+		v.lines, v.deleted = lines, false
+		v.rev++
+		v.setText(lines, matches, changed)
 		v.text.Highlight()
 		if v.currentRegion < v.maxRegions {
 			v.text.Highlight("search_" + strconv.Itoa(v.currentRegion))
 			v.text.ScrollToHighlight()
 		}
 		v.updateTitle()
+
+		if len(changed) == 0 {
+			return
+		}
+		rev := v.rev
+		time.AfterFunc(changeFlashDelay, func() {
+			v.app.QueueUpdateDraw(func() {
+				if rev == v.rev {
+					v.setText(lines, matches, nil)
+				}
+			})
+		})
 	})
 }
 
+func (v *LiveView) setText(lines []string, matches fuzzy.Matches, changed map[int]struct{}) {
+	lines = linesWithRegions(lines, matches)
+	style := v.app.Styles.Views().Yaml
+	s := colorizeYAML(style, strings.Join(lines, "\n"))
+	if len(changed) > 0 {
+		ll := strings.Split(s, "\n")
+		for i := range changed {
+			if i < len(ll) {
+				ll[i] = fmt.Sprintf(liveChangeFmt, style.ChangeBgColor, ll[i])
+			}
+		}
+		s = strings.Join(ll, "\n")
+	}
+	v.text.SetText(s)
+}
+
+// changedLines returns the indices of lines added or updated since the previous revision.
+func changedLines(prev, lines []string) map[int]struct{} {
+	if len(prev) == 0 {
+		return nil
+	}
+	cc := make(map[int]struct{})
+	for _, op := range difflib.NewMatcher(prev, lines).GetOpCodes() {
+		if op.Tag != 'r' && op.Tag != 'i' {
+			continue
+		}
+		for i := op.J1; i < op.J2; i++ {
+			cc[i] = struct{}{}
+		}
+	}
+
+	return cc
+}
+
 // BufferChanged indicates the buffer was changed.
 func (*LiveView) BufferChanged(_, _ string) {}
 
@@ -150,6 +234,7 @@ func (v *LiveView) bindKeys() {
 		ui.KeyC:         ui.NewKeyAction("Copy", cpCmd(v.app.Flash(), v.text), true),
 		ui.KeyF:         ui.NewKeyAction("Toggle FullScreen", v.toggleFullScreenCmd, true),
 		ui.KeyR:         ui.NewKeyAction("Toggle Auto-Refresh", v.toggleRefreshCmd, true),
+		ui.KeyShiftC:    ui.NewKeyAction("Toggle Changes", v.toggleChangesCmd, true),
 		ui.KeyN:         ui.NewKeyAction("Next Match", v.nextCmd, true),
 		ui.KeyShiftN:    ui.NewKeyAction("Prev Match", v.prevCmd, true),
 		ui.KeySlash:     ui.NewSharedKeyAction("Filter Mode", v.activateCmd, false),
@@ -206,6 +291,21 @@ func (v *LiveView) toggleRefreshCmd(*tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (v *LiveView) toggleChangesCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if v.app.InCmdMode() {
+		return evt
+	}
+
+	v.flashChanges = !v.flashChanges
+	if v.flashChanges {
+		v.app.Flash().Info("Changes highlight is enabled")
+		return nil
+	}
+	v.app.Flash().Info("Changes highlight is disabled")
+
+	return nil
+}
+
 func (v *LiveView) keyboard(evt *tcell.EventKey) *tcell.EventKey {
 	if a, ok := v.actions.Get(ui.AsKey(evt)); ok {
 		return a.Action(evt)
@@ -273,7 +373,7 @@ func (v *LiveView) toggleManagedCmd(evt *tcell.EventKey) *tcell.EventKey {
 		return evt
 	}
 
-	v.managedField = !v.managedField
+	v.managedField, v.lines = !v.managedField, nil
 	v.model.SetOptions(v.defaultCtx(), map[string]bool{model.ManagedFieldsOpts: v.managedField})
 
 	v.app.Flash().Info("toggled managed fields")
@@ -394,6 +494,9 @@ func (v *LiveView) updateTitle() {
 	if v.model != nil {
 		fmat = fmt.Sprintf(liveViewTitleFmt, v.title, v.model.GetPath())
 	}
+	if v.deleted {
+		fmat += liveDeletedFmt
+	}
 
 	var (
 		buff   = v.cmdBuff.GetText()
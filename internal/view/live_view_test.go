@@ -26,3 +26,41 @@ apiVersion: v1
 
 	assert.Equal(t, s, sanitizeEsc(v.text.GetText(true)))
 }
+
+func TestChangedLines(t *testing.T) {
+	uu := map[string]struct {
+		prev, lines []string
+		e           map[int]struct{}
+	}{
+		"first": {
+			lines: []string{"a: 1"},
+		},
+		"same": {
+			prev:  []string{"a: 1", "b: 2"},
+			lines: []string{"a: 1", "b: 2"},
+			e:     map[int]struct{}{},
+		},
+		"updated": {
+			prev:  []string{"a: 1", "b: 2", "c: 3"},
+			lines: []string{"a: 1", "b: 20", "c: 3"},
+			e:     map[int]struct{}{1: {}},
+		},
+		"inserted": {
+			prev:  []string{"a: 1", "c: 3"},
+			lines: []string{"a: 1", "b: 2", "c: 3", "d: 4"},
+			e:     map[int]struct{}{1: {}, 3: {}},
+		},
+		"deleted": {
+			prev:  []string{"a: 1", "b: 2", "c: 3"},
+			lines: []string{"a: 1", "c: 3"},
+			e:     map[int]struct{}{},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, changedLines(u.prev, u.lines))
+		})
+	}
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/derailed/k9s/internal/view/cmd"
 	"github.com/derailed/tcell/v2"
 	"github.com/derailed/tview"
+	"github.com/mattn/go-runewidth"
 	"k8s.io/apimachinery/pkg/labels"
 )
 
@@ -50,6 +51,7 @@ type Log struct {
 	mx                sync.Mutex
 	follow            bool
 	requestOneRefresh bool
+	markStart         time.Time
 }
 
 var _ model.Component = (*Log)(nil)
@@ -110,6 +112,27 @@ func (l *Log) Init(ctx context.Context) (err error) {
 	return nil
 }
 
+func (l *Log) updateLevels() {
+	if !l.indicator.LevelColors() {
+		l.model.SetLogLevels(nil)
+		return
+	}
+	cc := l.app.Styles.Views().Log.Levels
+	lv, err := dao.NewLogLevels(l.app.Config.K9s.Logger.LevelPatterns, map[dao.LogLevel]string{
+		dao.LevelDebug: cc.DebugColor.String(),
+		dao.LevelInfo:  cc.InfoColor.String(),
+		dao.LevelWarn:  cc.WarnColor.String(),
+		dao.LevelError: cc.ErrorColor.String(),
+		dao.LevelFatal: cc.FatalColor.String(),
+	})
+	if err != nil {
+		slog.Warn("Log levels load failed", slogs.Error, err)
+		l.app.Flash().Err(err)
+		return
+	}
+	l.model.SetLogLevels(lv)
+}
+
 // InCmdMode checks if prompt is active.
 func (l *Log) InCmdMode() bool {
 	return l.logs.cmdBuff.InCmdMode()
@@ -187,6 +210,9 @@ func (l *Log) StylesChanged(s *config.Styles) {
 	l.SetBackgroundColor(s.Views().Log.BgColor.Color())
 	l.logs.SetTextColor(s.Views().Log.FgColor.Color())
 	l.logs.SetBackgroundColor(s.Views().Log.BgColor.Color())
+	if l.model != nil && l.indicator != nil {
+		l.updateLevels()
+	}
 }
 
 // GetModel returns the log model.
@@ -257,9 +283,14 @@ func (l *Log) bindKeys() {
 		tcell.KeyEscape: ui.NewKeyAction("Back", l.resetCmd, false),
 		ui.KeyShiftC:    ui.NewKeyAction("Clear", l.clearCmd, true),
 		ui.KeyM:         ui.NewKeyAction("Mark", l.markCmd, true),
+		ui.KeyShiftM:    ui.NewKeyAction("Mark Start", l.markStartCmd, true),
+		ui.KeyShiftY:    ui.NewKeyAction("Copy From Mark", l.copyFromMarkCmd, true),
+		tcell.KeyCtrlY:  ui.NewKeyAction("Save From Mark", l.saveFromMarkCmd, true),
 		ui.KeyS:         ui.NewKeyAction("Toggle AutoScroll", l.toggleAutoScrollCmd, true),
 		ui.KeyF:         ui.NewKeyAction("Toggle FullScreen", l.toggleFullScreenCmd, true),
 		ui.KeyT:         ui.NewKeyAction("Toggle Timestamp", l.toggleTimestampCmd, true),
+		ui.KeyL:         ui.NewKeyAction("Toggle Levels", l.toggleLevelsCmd, true),
+		ui.KeyShiftS:    ui.NewKeyAction("Toggle Snapshot", l.toggleSnapshotCmd, true),
 		ui.KeyW:         ui.NewKeyAction("Toggle Wrap", l.toggleTextWrapCmd, true),
 		tcell.KeyCtrlS:  ui.NewKeyAction("Save", l.SaveCmd, true),
 		ui.KeyC:         ui.NewKeyAction("Copy", cpCmd(l.app.Flash(), l.logs.TextView), true),
@@ -312,6 +343,9 @@ func (l *Log) updateTitle() {
 	if l.model.IsHead() {
 		since = "head"
 	}
+	if l.model.IsSnapshot() {
+		since = fmt.Sprintf("snapshot:%d", l.model.LogOptions().SnapshotLines)
+	}
 
 	title := " Logs"
 	if l.model.LogOptions().Previous {
@@ -386,6 +420,19 @@ func (l *Log) sinceCmd(n int) func(evt *tcell.EventKey) *tcell.EventKey {
 	}
 }
 
+func (l *Log) toggleSnapshotCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if l.app.InCmdMode() {
+		return evt
+	}
+
+	l.logs.Clear()
+	l.model.ToggleSnapshot(l.getContext())
+	l.requestOneRefresh = true
+	l.updateTitle()
+
+	return nil
+}
+
 func (l *Log) toggleAllContainers(evt *tcell.EventKey) *tcell.EventKey {
 	if l.app.InCmdMode() {
 		return evt
@@ -470,6 +517,74 @@ func (l *Log) markCmd(*tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+// markStartCmd marks the top most visible log line as the copy start position.
+func (l *Log) markStartCmd(*tcell.EventKey) *tcell.EventKey {
+	row, _ := l.logs.GetScrollOffset()
+	_, _, w, _ := l.logs.GetInnerRect()
+	ll := strings.Split(strings.TrimSuffix(l.logs.GetText(true), "\n"), "\n")
+	idx, ok := lineAtRow(ll, row, w, l.indicator.TextWrap())
+	if !ok {
+		l.app.Flash().Warn("No log line to mark")
+		return nil
+	}
+	ts, ok := l.model.TimestampOf(ll[idx], idx, len(ll))
+	if !ok {
+		l.app.Flash().Warn("Unable to resolve marked log line timestamp")
+		return nil
+	}
+	l.markStart = ts
+	l.app.Flash().Infof("Log start marked at %s", ts.Format(time.RFC3339))
+
+	return nil
+}
+
+func (l *Log) copyFromMarkCmd(*tcell.EventKey) *tcell.EventKey {
+	logs, ok := l.logsFromMark()
+	if !ok {
+		return nil
+	}
+	if err := clipboardWrite(logs); err != nil {
+		l.app.Flash().Err(err)
+		return nil
+	}
+	l.app.Flash().Infof("Logs since %s copied to clipboard...", l.markStart.Format(time.RFC3339))
+
+	return nil
+}
+
+func (l *Log) saveFromMarkCmd(*tcell.EventKey) *tcell.EventKey {
+	logs, ok := l.logsFromMark()
+	if !ok {
+		return nil
+	}
+	path, err := saveData(l.app.Config.K9s.ContextScreenDumpDir(), l.model.GetPath(), logs)
+	if err != nil {
+		l.app.Flash().Err(err)
+		return nil
+	}
+	l.app.Flash().Infof("Log %s saved successfully!", path)
+
+	return nil
+}
+
+func (l *Log) logsFromMark() (string, bool) {
+	if l.markStart.IsZero() {
+		l.app.Flash().Warn("No log start marked")
+		return "", false
+	}
+	ll, err := l.model.LinesSince(l.markStart)
+	if err != nil {
+		l.app.Flash().Err(err)
+		return "", false
+	}
+	if len(ll) == 0 {
+		l.app.Flash().Warnf("No logs since %s", l.markStart.Format(time.RFC3339))
+		return "", false
+	}
+
+	return strings.Join(ll, "\n") + "\n", true
+}
+
 func (l *Log) toggleTimestampCmd(evt *tcell.EventKey) *tcell.EventKey {
 	if l.app.InCmdMode() {
 		return evt
@@ -482,6 +597,17 @@ func (l *Log) toggleTimestampCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (l *Log) toggleLevelsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if l.app.InCmdMode() {
+		return evt
+	}
+
+	l.indicator.ToggleLevelColors()
+	l.updateLevels()
+
+	return nil
+}
+
 func (l *Log) toggleTextWrapCmd(evt *tcell.EventKey) *tcell.EventKey {
 	if l.app.InCmdMode() {
 		return evt
@@ -528,6 +654,22 @@ func (l *Log) toggleFullScreen() {
 	}
 }
 
+// lineAtRow returns the index of the text line displayed at a given row accounting for wrapped lines.
+func lineAtRow(ll []string, row, width int, wrap bool) (int, bool) {
+	if !wrap || width <= 0 {
+		return row, row >= 0 && row < len(ll)
+	}
+	var r int
+	for i, line := range ll {
+		r += max(1, (runewidth.StringWidth(line)+width-1)/width)
+		if row < r {
+			return i, true
+		}
+	}
+
+	return 0, false
+}
+
 func (l *Log) isContainerLogView() bool {
 	return l.model.HasDefaultContainer()
 }
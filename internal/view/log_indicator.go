@@ -23,6 +23,7 @@ type LogIndicator struct {
 	fullScreen                 bool
 	textWrap                   bool
 	showTime                   bool
+	levelColors                bool
 	allContainers              bool
 	shouldDisplayAllContainers bool
 }
@@ -37,6 +38,7 @@ func NewLogIndicator(cfg *config.Config, styles *config.Styles, allContainers bo
 		fullScreen:                 cfg.K9s.UI.DefaultsToFullScreen,
 		textWrap:                   cfg.K9s.Logger.TextWrap,
 		showTime:                   cfg.K9s.Logger.ShowTime,
+		levelColors:                !cfg.K9s.Logger.DisableLevelColors,
 		shouldDisplayAllContainers: allContainers,
 	}
 
@@ -68,6 +70,11 @@ func (l *LogIndicator) Timestamp() bool {
 	return l.showTime
 }
 
+// LevelColors reports the current log level colors mode.
+func (l *LogIndicator) LevelColors() bool {
+	return l.levelColors
+}
+
 // TextWrap reports the current wrap mode.
 func (l *LogIndicator) TextWrap() bool {
 	return l.textWrap
@@ -83,6 +90,12 @@ func (l *LogIndicator) ToggleTimestamp() {
 	l.showTime = !l.showTime
 }
 
+// ToggleLevelColors toggles the log level colors mode.
+func (l *LogIndicator) ToggleLevelColors() {
+	l.levelColors = !l.levelColors
+	l.Refresh()
+}
+
 // ToggleFullScreen toggles the screen mode.
 func (l *LogIndicator) ToggleFullScreen() {
 	l.fullScreen = !l.fullScreen
@@ -152,6 +165,12 @@ func (l *LogIndicator) Refresh() {
 		l.indicator = append(l.indicator, fmt.Sprintf(toggleOffFmt, "Timestamps", spacer)...)
 	}
 
+	if l.LevelColors() {
+		l.indicator = append(l.indicator, fmt.Sprintf(toggleOnFmt, "Levels", spacer)...)
+	} else {
+		l.indicator = append(l.indicator, fmt.Sprintf(toggleOffFmt, "Levels", spacer)...)
+	}
+
 	if l.TextWrap() {
 		l.indicator = append(l.indicator, fmt.Sprintf(toggleOnFmt, "Wrap", "")...)
 	} else {
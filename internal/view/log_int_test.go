@@ -27,10 +27,10 @@ func TestLogAutoScroll(t *testing.T) {
 	v.GetModel().Set(ii)
 	v.GetModel().Notify()
 
-	assert.Len(t, v.Hints(), 16)
+	assert.Len(t, v.Hints(), 21)
 
 	v.toggleAutoScrollCmd(nil)
-	assert.Equal(t, "Autoscroll:Off     FullScreen:Off     Timestamps:Off     Wrap:Off", v.Indicator().GetText(true))
+	assert.Equal(t, "Autoscroll:Off     FullScreen:Off     Timestamps:Off     Levels:On      Wrap:Off", v.Indicator().GetText(true))
 }
 
 func TestLogViewNav(t *testing.T) {
@@ -141,3 +141,54 @@ func (*logList) LogStop()          {}
 func (*logList) LogResume()        {}
 func (l *logList) LogCleared()     { l.clear++ }
 func (l *logList) LogFailed(error) { l.fail++ }
+
+func TestLineAtRow(t *testing.T) {
+	uu := map[string]struct {
+		row, width int
+		wrap       bool
+		ok         bool
+		e          int
+	}{
+		"first": {
+			ok: true,
+		},
+		"no-wrap": {
+			row: 1,
+			e:   1,
+			ok:  true,
+		},
+		"wrapped": {
+			row:   1,
+			width: 5,
+			wrap:  true,
+			ok:    true,
+		},
+		"after-wrap": {
+			row:   2,
+			width: 5,
+			wrap:  true,
+			e:     1,
+			ok:    true,
+		},
+		"out": {
+			row: 3,
+		},
+		"out-wrapped": {
+			row:   3,
+			width: 5,
+			wrap:  true,
+		},
+	}
+
+	ll := []string{"aaaaaaaaaa", "b"}
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			idx, ok := lineAtRow(ll, u.row, u.width, u.wrap)
+			assert.Equal(t, u.ok, ok)
+			if ok {
+				assert.Equal(t, u.e, idx)
+			}
+		})
+	}
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/slogs"
 	"github.com/derailed/k9s/internal/ui"
@@ -130,7 +131,9 @@ func drainNode(v ResourceViewer, sels []string, opts dao.DrainOptions) {
 			v.App().Flash().Err(err)
 		}
 		for _, sel := range sels {
-			if err := m.Drain(sel, opts, d.GetWriter()); err != nil {
+			err := m.Drain(sel, opts, d.GetWriter())
+			v.App().audit(config.AuditDrain, v.GVR(), sel, err)
+			if err != nil {
 				v.App().Flash().Err(err)
 			}
 		}
@@ -168,8 +171,14 @@ func (n *Node) toggleCordonCmd(cordon bool) func(evt *tcell.EventKey) *tcell.Eve
 				n.App().Flash().Err(fmt.Errorf("expecting a maintainer for %q", n.GVR()))
 				return
 			}
+			action := config.AuditUncordon
+			if cordon {
+				action = config.AuditCordon
+			}
 			for _, s := range sels {
-				if err := m.ToggleCordon(s, cordon); err != nil {
+				err := m.ToggleCordon(s, cordon)
+				n.App().audit(action, n.GVR(), s, err)
+				if err != nil {
 					n.App().Flash().Err(err)
 				}
 			}
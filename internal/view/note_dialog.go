@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"fmt"
+
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+)
+
+const (
+	noteKey       = "note"
+	noteIndicator = " ✎"
+)
+
+// ShowNote pops a resource note edit dialog.
+func ShowNote(view ResourceViewer, path string) {
+	var (
+		app     = view.App()
+		styles  = app.Styles.Dialog()
+		gvr     = view.GVR().String()
+		note, _ = app.notes.Get(gvr, path)
+	)
+
+	f := tview.NewForm().
+		SetItemPadding(0).
+		SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(styles.ButtonBgColor.Color()).
+		SetButtonTextColor(styles.ButtonFgColor.Color()).
+		SetLabelColor(styles.LabelFgColor.Color()).
+		SetFieldTextColor(styles.FieldFgColor.Color()).
+		SetFieldBackgroundColor(styles.BgColor.Color())
+
+	f.AddInputField("Note:", note, 0, nil, func(v string) {
+		note = v
+	})
+
+	pages := app.Content.Pages
+	save := func(n, msg string) {
+		DismissNote(view, pages)
+		if err := app.notes.Set(gvr, path, n); err != nil {
+			app.Flash().Err(err)
+			return
+		}
+		view.GetTable().Refresh()
+		app.Flash().Info(msg)
+	}
+	f.AddButton("Cancel", func() {
+		DismissNote(view, pages)
+	})
+	f.AddButton("Clear", func() {
+		save("", fmt.Sprintf("Note cleared for %s", path))
+	})
+	f.AddButton("OK", func() {
+		save(note, fmt.Sprintf("Note saved for %s", path))
+	})
+	for i := range 3 {
+		if b := f.GetButton(i); b != nil {
+			b.SetBackgroundColorActivated(styles.ButtonFocusBgColor.Color())
+			b.SetLabelColorActivated(styles.ButtonFocusFgColor.Color())
+		}
+	}
+
+	modal := tview.NewModalForm("<Note>", f)
+	modal.SetText(fmt.Sprintf("Local note for %s %s", view.GVR().R(), path))
+	modal.SetDoneFunc(func(int, string) {
+		DismissNote(view, pages)
+	})
+
+	pages.AddPage(noteKey, modal, false, true)
+	pages.ShowPage(noteKey)
+	app.SetFocus(pages.GetPrimitive(noteKey))
+}
+
+// DismissNote dismiss the note dialog.
+func DismissNote(v ResourceViewer, p *ui.Pages) {
+	p.RemovePage(noteKey)
+	v.App().SetFocus(p.CurrentPage().Item)
+}
@@ -14,6 +14,7 @@ import (
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/model1"
@@ -32,15 +33,16 @@ import (
 )
 
 const (
-	windowsOS        = "windows"
-	powerShell       = "powershell"
-	osSelector       = "kubernetes.io/os"
-	osBetaSelector   = "beta." + osSelector
-	trUpload         = "Upload"
-	trDownload       = "Download"
-	pfIndicator      = "[orange::b]Ⓕ"
-	defaultTxRetries = 999
-	magicPrompt      = "Yes Please!"
+	windowsOS         = "windows"
+	powerShell        = "powershell"
+	osSelector        = "kubernetes.io/os"
+	osBetaSelector    = "beta." + osSelector
+	trUpload          = "Upload"
+	trDownload        = "Download"
+	pfIndicator       = "[orange::b]Ⓕ"
+	defaultTxRetries  = 999
+	magicPrompt       = "Yes Please!"
+	maxCrashLoopNames = 10
 )
 
 // Pod represents a pod viewer.
@@ -121,6 +123,13 @@ func (p *Pod) bindDangerousKeys(aa *ui.KeyActions) {
 				Visible:   true,
 				Dangerous: true,
 			}),
+		ui.KeyShiftB: ui.NewKeyActionWithOpts(
+			"Restart CrashLoops",
+			p.restartCrashLoopsCmd,
+			ui.ActionOpts{
+				Visible:   true,
+				Dangerous: true,
+			}),
 	})
 }
 
@@ -131,6 +140,7 @@ func (p *Pod) bindKeys(aa *ui.KeyActions) {
 
 	aa.Bulk(ui.KeyMap{
 		ui.KeyO:      ui.NewKeyAction("Show Node", p.showNode, true),
+		ui.KeyShiftK: ui.NewKeyAction("Scheduling", p.schedulingCmd, true),
 		ui.KeyShiftR: ui.NewKeyAction("Sort Ready", p.GetTable().SortColCmd(readyCol, true), false),
 		ui.KeyShiftT: ui.NewKeyAction("Sort Restart", p.GetTable().SortColCmd("RESTARTS", false), false),
 		ui.KeyShiftS: ui.NewKeyAction("Sort Status", p.GetTable().SortColCmd(statusCol, true), false),
@@ -140,6 +150,35 @@ func (p *Pod) bindKeys(aa *ui.KeyActions) {
 	aa.Merge(resourceSorters(p.GetTable()))
 }
 
+func (p *Pod) schedulingCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := p.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	pod, err := fetchPod(p.App().factory, path)
+	if err != nil {
+		p.App().Flash().Err(err)
+		return nil
+	}
+
+	var expand bool
+	details := NewDetails(p.App(), "Scheduling", path, contentTXT, true)
+	update := func() {
+		details.Update(strings.Join(render.PodScheduling(&pod.Spec, expand), "\n"))
+	}
+	details.Actions().Add(ui.KeyX, ui.NewKeyAction("Toggle Expand", func(*tcell.EventKey) *tcell.EventKey {
+		expand = !expand
+		update()
+		return nil
+	}, true))
+	update()
+	if err := p.App().inject(details, false); err != nil {
+		p.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
 func (p *Pod) logOptions(prev bool) (*dao.LogOptions, error) {
 	path := p.GetTable().GetSelectedItem()
 	if path == "" {
@@ -214,7 +253,9 @@ func (p *Pod) killCmd(evt *tcell.EventKey) *tcell.EventKey {
 	}
 	p.GetTable().ShowDeleted()
 	for _, path := range selections {
-		if err := nuker.Delete(context.Background(), path, nil, dao.NowGrace); err != nil {
+		err := nuker.Delete(context.Background(), path, nil, dao.NowGrace)
+		p.App().audit(config.AuditKill, p.GVR(), path, err)
+		if err != nil {
 			p.App().Flash().Errf("Delete failed with %s", err)
 		} else {
 			p.App().factory.DeleteForwarder(path)
@@ -290,6 +331,85 @@ func (p *Pod) sanitizeCmd(*tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (p *Pod) restartCrashLoopsCmd(*tcell.EventKey) *tcell.EventKey {
+	ns := p.GetTable().GetModel().GetNamespace()
+	if client.IsClusterWide(ns) {
+		p.App().Flash().Warn("Select a namespace to restart crashlooping pods")
+		return nil
+	}
+	res, err := dao.AccessorFor(p.App().factory, p.GVR())
+	if err != nil {
+		p.App().Flash().Err(err)
+		return nil
+	}
+	looper, ok := res.(dao.CrashLooper)
+	if !ok {
+		p.App().Flash().Err(fmt.Errorf("expecting a crashlooper for %q", p.GVR()))
+		return nil
+	}
+	nuker, ok := res.(dao.Nuker)
+	if !ok {
+		p.App().Flash().Err(fmt.Errorf("expecting a nuker for %q", p.GVR()))
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), p.App().Conn().Config().CallTimeout())
+	defer cancel()
+	pp, err := looper.CrashLooping(ctx, ns)
+	if err != nil {
+		p.App().Flash().Err(err)
+		return nil
+	}
+	if len(pp) == 0 {
+		p.App().Flash().Info("No crashlooping pods found")
+		return nil
+	}
+
+	paths, names := make([]string, 0, len(pp)), make([]string, 0, len(pp))
+	for _, po := range pp {
+		fqn := client.FQN(po.Namespace, po.Name)
+		paths = append(paths, fqn)
+		if len(po.OwnerReferences) == 0 {
+			fqn += " (unmanaged!)"
+		}
+		names = append(names, fqn)
+	}
+	if len(names) > maxCrashLoopNames {
+		names = append(names[:maxCrashLoopNames], fmt.Sprintf("%s(+%d)", render.Ellipsis(), len(pp)-maxCrashLoopNames))
+	}
+	msg := fmt.Sprintf("Delete %d crashlooping pod(s)?\n%s", len(pp), strings.Join(names, "\n"))
+	d := p.App().Styles.Dialog()
+	dialog.ShowConfirm(&d, p.App().Content.Pages, "Restart CrashLoops", msg, func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*p.App().Conn().Config().CallTimeout())
+		defer cancel()
+		var failed int
+		rr := make([]string, 0, len(paths))
+		for _, path := range paths {
+			err := nuker.Delete(ctx, path, nil, dao.DefaultGrace)
+			p.App().audit(config.AuditDelete, p.GVR(), path, err)
+			if err != nil {
+				failed++
+				rr = append(rr, fmt.Sprintf("FAILED   %s: %s", path, err))
+				continue
+			}
+			rr = append(rr, "DELETED  "+path)
+		}
+		if failed > 0 {
+			p.App().Flash().Warnf("Restarted %d/%d crashlooping pods", len(paths)-failed, len(paths))
+		} else {
+			p.App().Flash().Infof("Restarted %d crashlooping pods", len(paths))
+		}
+		details := NewDetails(p.App(), "CrashLoops", ns, contentTXT, false).
+			Update(strings.Join(rr, "\n"))
+		if err := p.App().inject(details, false); err != nil {
+			p.App().Flash().Err(err)
+		}
+		p.Refresh()
+	}, func() {})
+
+	return nil
+}
+
 func (p *Pod) transferCmd(*tcell.EventKey) *tcell.EventKey {
 	path := p.GetTable().GetSelectedItem()
 	if path == "" {
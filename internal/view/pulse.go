@@ -25,7 +25,7 @@ import (
 )
 
 const (
-	cpuFmt     = " %s [%s::b]%s[white::-]([%s::]%sm[white::]/[%s::]%sm[-::])"
+	cpuFmt     = " %s [%s::b]%s[white::-]([%s::]%s[white::]/[%s::]%s[-::])"
 	memFmt     = " %s [%s::b]%s[white::-]([%s::]%sMi[white::]/[%s::]%sMi[-::])"
 	pulseTitle = "Pulses"
 	NSTitleFmt = "[fg:bg:b] %s([hilite:bg:b]%s[fg:bg:-])[fg:bg:-] "
@@ -215,9 +215,9 @@ func (p *Pulse) SeriesChanged(tt dao.TimeSeries) {
 		p.app.Config.K9s.Thresholds.SeverityColor("cpu", perc),
 		render.PrintPerc(perc),
 		nn[index],
-		render.AsThousands(last.Value.CurrentCPU),
+		render.FormatCPU(last.Value.CurrentCPU, client.CPUMillicores),
 		"white",
-		render.AsThousands(int64(cpu.GetMax())),
+		render.FormatCPU(int64(cpu.GetMax()), client.CPUMillicores),
 	))
 
 	nn = mem.GetSeriesColorNames()
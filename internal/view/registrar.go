@@ -81,6 +81,9 @@ func miscViewers(vv MetaViewers) {
 	vv[client.BeGVR] = MetaViewer{
 		viewerFn: NewBenchmark,
 	}
+	vv[client.WarnGVR] = MetaViewer{
+		viewerFn: NewWarning,
+	}
 	vv[client.AliGVR] = MetaViewer{
 		viewerFn: NewAlias,
 	}
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/k9s/internal/ui/dialog"
@@ -89,7 +90,10 @@ func (r *RestartExtender) restartRollout(ctx context.Context, path string, opts
 		return errors.New("resource is not restartable")
 	}
 
-	return s.Restart(ctx, path, opts)
+	err = s.Restart(ctx, path, opts)
+	r.App().audit(config.AuditRestart, r.GVR(), path, err)
+
+	return err
 }
 
 // Helpers...
@@ -7,6 +7,7 @@ import (
 	"fmt"
 
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/k9s/internal/ui/dialog"
@@ -66,7 +67,9 @@ func (r *ReplicaSet) rollbackCmd(evt *tcell.EventKey) *tcell.EventKey {
 		r.App().Flash().Infof("Rolling back %s %s", r.GVR(), path)
 		var drs dao.ReplicaSet
 		drs.Init(r.App().factory, r.GVR())
-		if err := drs.Rollback(path); err != nil {
+		err := drs.Rollback(path)
+		r.App().audit(config.AuditRollback, r.GVR(), path, err)
+		if err != nil {
 			r.App().Flash().Err(err)
 		} else {
 			r.App().Flash().Infof("%s successfully rolled back", path)
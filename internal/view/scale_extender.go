@@ -10,6 +10,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/slogs"
 	"github.com/derailed/k9s/internal/ui"
@@ -225,5 +226,8 @@ func (s *ScaleExtender) scale(ctx context.Context, path string, replicas int32)
 		return fmt.Errorf("expecting a scalable resource for %q", s.GVR())
 	}
 
-	return scaler.Scale(ctx, path, replicas)
+	err = scaler.Scale(ctx, path, replicas)
+	s.App().audit(config.AuditScale, s.GVR(), fmt.Sprintf("%s replicas=%d", path, replicas), err)
+
+	return err
 }
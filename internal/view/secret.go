@@ -4,14 +4,22 @@
 package view
 
 import (
+	"errors"
+	"fmt"
+	"time"
+
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config/data"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
 	"github.com/derailed/tcell/v2"
 	"k8s.io/apimachinery/pkg/labels"
 )
 
+// tokenKey tracks service account token secrets data key.
+const tokenKey = "token"
+
 // Secret presents a secret viewer.
 type Secret struct {
 	ResourceViewer
@@ -29,8 +37,9 @@ func NewSecret(gvr *client.GVR) ResourceViewer {
 
 func (s *Secret) bindKeys(aa *ui.KeyActions) {
 	aa.Bulk(ui.KeyMap{
-		ui.KeyX: ui.NewKeyAction("Decode", s.decodeCmd, true),
-		ui.KeyU: ui.NewKeyAction("UsedBy", s.refCmd, true),
+		ui.KeyX:      ui.NewKeyAction("Decode", s.decodeCmd, true),
+		ui.KeyU:      ui.NewKeyAction("UsedBy", s.refCmd, true),
+		ui.KeyShiftX: ui.NewKeyAction("Decode Token", s.decodeTokenCmd, true),
 	})
 }
 
@@ -69,3 +78,54 @@ func (s *Secret) decodeCmd(evt *tcell.EventKey) *tcell.EventKey {
 
 	return nil
 }
+
+func (s *Secret) decodeTokenCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := s.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	d := s.App().Styles.Dialog()
+	msg := fmt.Sprintf("Decode token from secret %s?", path)
+	dialog.ShowConfirm(&d, s.App().Content.Pages, "Decode Token", msg, func() {
+		if err := s.decodeToken(path); err != nil {
+			s.App().Flash().Err(err)
+		}
+	}, func() {})
+
+	return nil
+}
+
+func (s *Secret) decodeToken(path string) error {
+	o, err := s.App().factory.Get(s.GVR(), path, true, labels.Everything())
+	if err != nil {
+		return err
+	}
+	mm, err := dao.ExtractSecrets(o)
+	if err != nil {
+		return err
+	}
+	token, ok := mm[tokenKey]
+	if !ok {
+		return fmt.Errorf("no %q data key found in secret %s", tokenKey, path)
+	}
+	jwt, err := dao.ParseJWT(token)
+	if errors.Is(err, dao.ErrNotJWT) {
+		return fmt.Errorf("secret %s %q data is not a JWT", path, tokenKey)
+	}
+	if err != nil {
+		return err
+	}
+
+	sum := jwt.Summary(time.Now())
+	raw, err := data.WriteYAML(sum)
+	if err != nil {
+		return err
+	}
+	if jwt.Expired(time.Now()) {
+		s.App().Flash().Warnf("Token from secret %s has expired", path)
+	}
+	details := NewDetails(s.App(), "Token Decoder", path, contentYAML, true).Update(string(raw))
+
+	return s.App().inject(details, false)
+}
@@ -0,0 +1,134 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"fmt"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const snapshotKey = "snapshot"
+
+// ShowSnapshots pops a dialog to take or diff resource snapshots.
+// A first snapshot is taken right away when none exist.
+func ShowSnapshots(view ResourceViewer, path string) {
+	app := view.App()
+	ss := app.snapshots.List(view.GVR(), path)
+	if len(ss) == 0 {
+		takeSnapshot(app, view, path)
+		return
+	}
+
+	styles := app.Styles.Dialog()
+	f := tview.NewForm().
+		SetItemPadding(0).
+		SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(styles.ButtonBgColor.Color()).
+		SetButtonTextColor(styles.ButtonFgColor.Color()).
+		SetLabelColor(styles.LabelFgColor.Color()).
+		SetFieldTextColor(styles.FieldFgColor.Color()).
+		SetFieldBackgroundColor(styles.BgColor.Color())
+
+	options, sel := make([]string, 0, len(ss)), len(ss)-1
+	for i, s := range ss {
+		options = append(options, fmt.Sprintf("#%d %s", i+1, s.Label()))
+	}
+	f.AddDropDown("Compare:", options, sel, func(_ string, idx int) {
+		sel = idx
+	})
+	if dd, ok := f.GetFormItemByLabel("Compare:").(*tview.DropDown); ok {
+		dd.SetListStyles(
+			styles.FgColor.Color(), styles.BgColor.Color(),
+			styles.ButtonFocusFgColor.Color(), styles.ButtonFocusBgColor.Color(),
+		)
+	}
+
+	pages := app.Content.Pages
+	f.AddButton("Cancel", func() {
+		DismissSnapshots(view, pages)
+	})
+	f.AddButton("Clear", func() {
+		DismissSnapshots(view, pages)
+		app.snapshots.Delete(view.GVR(), path)
+		app.Flash().Infof("Snapshots cleared for %s", path)
+	})
+	f.AddButton("Take", func() {
+		DismissSnapshots(view, pages)
+		takeSnapshot(app, view, path)
+	})
+	f.AddButton("Diff", func() {
+		DismissSnapshots(view, pages)
+		if err := diffSnapshot(app, view, path, ss[sel]); err != nil {
+			app.Flash().Err(err)
+		}
+	})
+	for i := range 4 {
+		if b := f.GetButton(i); b != nil {
+			b.SetBackgroundColorActivated(styles.ButtonFocusBgColor.Color())
+			b.SetLabelColorActivated(styles.ButtonFocusFgColor.Color())
+		}
+	}
+
+	modal := tview.NewModalForm("<Snapshots>", f)
+	modal.SetText(fmt.Sprintf("Diff %s %s against a snapshot (%d taken)?", view.GVR().R(), path, len(ss)))
+	modal.SetDoneFunc(func(int, string) {
+		DismissSnapshots(view, pages)
+	})
+
+	pages.AddPage(snapshotKey, modal, false, true)
+	pages.ShowPage(snapshotKey)
+	app.SetFocus(pages.GetPrimitive(snapshotKey))
+}
+
+// DismissSnapshots dismiss the snapshots dialog.
+func DismissSnapshots(v ResourceViewer, p *ui.Pages) {
+	p.RemovePage(snapshotKey)
+	v.App().SetFocus(p.CurrentPage().Item)
+}
+
+func takeSnapshot(app *App, view ResourceViewer, path string) {
+	live, err := fetchLive(app, view, path)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	snap := app.snapshots.Take(view.GVR(), live)
+	app.Flash().Infof("Snapshot %s taken for %s", snap.Label(), path)
+}
+
+func diffSnapshot(app *App, view ResourceViewer, path string, snap dao.Snapshot) error {
+	live, err := fetchLive(app, view, path)
+	if err != nil {
+		return err
+	}
+	res, err := dao.DiffSnapshot(snap, live)
+	if err != nil {
+		return err
+	}
+	if res == "" {
+		app.Flash().Infof("No changes on %s since %s", path, snap.Label())
+		return nil
+	}
+	details := NewDetails(app, "Diff", path, contentDiff, true).Update(res)
+
+	return app.inject(details, false)
+}
+
+func fetchLive(app *App, view ResourceViewer, path string) (*unstructured.Unstructured, error) {
+	o, err := app.factory.Get(view.GVR(), path, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	live, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expecting unstructured but got %T", o)
+	}
+
+	return live, nil
+}
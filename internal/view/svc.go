@@ -52,6 +52,8 @@ func NewService(gvr *client.GVR) ResourceViewer {
 func (s *Service) bindKeys(aa *ui.KeyActions) {
 	aa.Bulk(ui.KeyMap{
 		ui.KeyB:      ui.NewKeyAction("Bench Run/Stop", s.toggleBenchCmd, true),
+		ui.KeyF:      ui.NewKeyAction("Show PortForward", s.showPFCmd, true),
+		ui.KeyShiftF: ui.NewKeyAction("Port-Forward", s.portFwdCmd, true),
 		ui.KeyShiftT: ui.NewKeyAction("Sort Type", s.GetTable().SortColCmd("TYPE", true), false),
 	})
 }
@@ -0,0 +1,221 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/port"
+	"github.com/derailed/k9s/internal/slogs"
+	"github.com/derailed/k9s/internal/watch"
+	"github.com/derailed/tcell/v2"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/portforward"
+)
+
+const (
+	svcFwdRate          = 2 * time.Second
+	svcFwdRetargetLimit = time.Minute
+)
+
+func (s *Service) portFwdCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := s.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	svc, err := fetchService(s.App().factory, path)
+	if err != nil {
+		s.App().Flash().Err(err)
+		return nil
+	}
+	if svc.Spec.Type == v1.ServiceTypeExternalName {
+		s.App().Flash().Errf("Service %s is an external service", path)
+		return nil
+	}
+	ports := make(port.ContainerPortSpecs, 0, len(svc.Spec.Ports))
+	for _, sp := range svc.Spec.Ports {
+		if sp.Protocol != "" && sp.Protocol != v1.ProtocolTCP {
+			continue
+		}
+		ports = append(ports, port.NewPortSpec(svc.Name, sp.Name, sp.Port))
+	}
+	if len(ports) == 0 {
+		s.App().Flash().Errf("No TCP ports exposed on service %s", path)
+		return nil
+	}
+	ShowPortForwards(s, path, ports, svc.Annotations, startSvcFwdCB)
+
+	return nil
+}
+
+// showPFCmd shows the port-forwards of the pod currently backing the selected service.
+func (s *Service) showPFCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := s.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	pod, ok := s.App().factory.Forwarders().ServicePod(path)
+	if !ok {
+		s.App().Flash().Errf("no port-forward defined")
+		return nil
+	}
+
+	pf := NewPortForward(client.PfGVR)
+	pf.SetContextFn(func(ctx context.Context) context.Context {
+		if bc := s.App().BenchFile; bc != "" {
+			ctx = context.WithValue(ctx, internal.KeyBenchCfg, bc)
+		}
+		return context.WithValue(ctx, internal.KeyPath, pod)
+	})
+	if err := s.App().inject(pf, false); err != nil {
+		s.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+func startSvcFwdCB(v ResourceViewer, path string, pts port.PortTunnels) error {
+	if err := pts.CheckAvailable(context.Background()); err != nil {
+		return err
+	}
+	svc, err := fetchService(v.App().factory, path)
+	if err != nil {
+		return err
+	}
+
+	tt := make([]string, 0, len(pts))
+	for _, pt := range pts {
+		sp, err := dao.ServicePortFor(svc, pt.ContainerPort)
+		if err != nil {
+			return err
+		}
+		canceled := new(atomic.Bool)
+		pf, fwd, err := startSvcFwd(v.App().factory, svc, sp, pt, "", canceled)
+		if err != nil {
+			return err
+		}
+		go runSvcForward(v, svc, sp, pt, canceled, pf, fwd)
+		tt = append(tt, fmt.Sprintf("%s via %s", pt.LocalPort, pf.Pod()))
+	}
+	v.App().Flash().Infof("Service PortForward activated %s", strings.Join(tt, ","))
+
+	return nil
+}
+
+// startSvcFwd starts a port forward on a ready pod backing a service.
+func startSvcFwd(f *watch.Factory, svc *v1.Service, sp v1.ServicePort, pt port.PortTunnel, skip string, canceled *atomic.Bool) (*dao.ServiceForwarder, *portforward.PortForwarder, error) {
+	pod, err := dao.ServingPod(f, svc, skip)
+	if err != nil {
+		return nil, nil, err
+	}
+	co, p, err := dao.ServiceTargetPort(sp, pod)
+	if err != nil {
+		return nil, nil, err
+	}
+	pt.Container, pt.ContainerPort = co, strconv.Itoa(int(p))
+
+	podPath := client.FQN(pod.Namespace, pod.Name)
+	if _, ok := f.ForwarderFor(dao.PortForwardID(podPath, pt.Container, pt.PortMap())); ok {
+		return nil, nil, fmt.Errorf("port-forward is already active on pod %s", podPath)
+	}
+	pf := dao.NewServiceForwarder(f, client.FQN(svc.Namespace, svc.Name), canceled)
+	fwd, err := pf.Start(podPath, pt)
+	if err != nil {
+		return nil, nil, err
+	}
+	slog.Debug(">>> Starting service port forward",
+		slogs.PFID, pf.ID(),
+		slogs.PFTunnel, pt,
+	)
+
+	return pf, fwd, nil
+}
+
+// runSvcForward runs a service port forward, re-targeting another ready pod
+// whenever the backing pod goes away until the forward gets deleted.
+func runSvcForward(v ResourceViewer, svc *v1.Service, sp v1.ServicePort, pt port.PortTunnel, canceled *atomic.Bool, pf *dao.ServiceForwarder, fwd *portforward.PortForwarder) {
+	f := v.App().factory
+	v.App().QueueUpdateDraw(func() {
+		DismissPortForwards(v, v.App().Content.Pages)
+	})
+
+	for {
+		f.AddForwarder(pf)
+		pf.SetActive(true)
+		ctx, cancel := context.WithCancel(context.Background())
+		go watchSvcFwdPod(ctx, f, pf)
+		err := fwd.ForwardPorts()
+		cancel()
+
+		carryOn, prev := pf.Retire(), pf
+		v.App().QueueUpdateDraw(func() {
+			f.DeleteForwarder(prev.ID())
+			prev.SetActive(false)
+		})
+		if !carryOn {
+			return
+		}
+		if err != nil {
+			slog.Warn("Service port-forward lost its backing pod",
+				slogs.PFID, prev.ID(),
+				slogs.Error, err,
+			)
+		}
+		if pf, fwd, err = retargetSvcFwd(f, svc, sp, pt, prev.Pod(), canceled); err != nil {
+			v.App().Flash().Warnf("Service PortForward %s stopped: %s", prev.Service(), err)
+			return
+		}
+		v.App().Flash().Infof("Service PortForward %s retargeted to pod %s", pf.Service(), pf.Pod())
+	}
+}
+
+// retargetSvcFwd waits on a new ready backing pod to resume a service forward.
+func retargetSvcFwd(f *watch.Factory, svc *v1.Service, sp v1.ServicePort, pt port.PortTunnel, skip string, canceled *atomic.Bool) (*dao.ServiceForwarder, *portforward.PortForwarder, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), svcFwdRetargetLimit)
+	defer cancel()
+
+	ticker := time.NewTicker(svcFwdRate)
+	defer ticker.Stop()
+	for {
+		pf, fwd, err := startSvcFwd(f, svc, sp, pt, skip, canceled)
+		if err == nil {
+			return pf, fwd, nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil, nil, err
+		case <-ticker.C:
+		}
+	}
+}
+
+// watchSvcFwdPod retargets a service forward once its backing pod stops serving.
+func watchSvcFwdPod(ctx context.Context, f dao.Factory, pf *dao.ServiceForwarder) {
+	ticker := time.NewTicker(svcFwdRate)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if pod, err := fetchPod(f, pf.Pod()); err != nil || !dao.IsPodServing(pod) {
+			slog.Debug("Service port-forward backing pod gone", slogs.FQN, pf.Pod())
+			pf.Retarget()
+			return
+		}
+	}
+}
@@ -0,0 +1,23 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"github.com/derailed/k9s/internal/client"
+)
+
+// Warning presents api server warnings collected during the session.
+type Warning struct {
+	ResourceViewer
+}
+
+// NewWarning returns a new viewer.
+func NewWarning(gvr *client.GVR) ResourceViewer {
+	w := Warning{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	w.GetTable().SetSortCol(ageCol, true)
+
+	return &w
+}
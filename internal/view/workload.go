@@ -11,6 +11,7 @@ import (
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/slogs"
@@ -141,7 +142,9 @@ func (w *Workload) resourceDelete(selections []string, msg string) {
 			if force {
 				grace = dao.ForceGrace
 			}
-			if err := w.GetTable().GetModel().Delete(w.defaultContext(gvr, fqn), fqn, propagation, grace); err != nil {
+			err := w.GetTable().GetModel().Delete(w.defaultContext(gvr, fqn), fqn, propagation, grace)
+			w.App().audit(config.AuditDelete, gvr, fqn, err)
+			if err != nil {
 				w.App().Flash().Errf("Delete failed with `%s", err)
 			} else {
 				w.App().factory.DeleteForwarder(sel)
@@ -720,7 +720,9 @@ func (x *Xray) resourceDelete(gvr *client.GVR, spec *xray.NodeSpec, msg string)
 		if force {
 			grace = dao.ForceGrace
 		}
-		if err := nuker.Delete(context.Background(), spec.Path(), nil, grace); err != nil {
+		err = nuker.Delete(context.Background(), spec.Path(), nil, grace)
+		x.app.audit(config.AuditDelete, gvr, spec.Path(), err)
+		if err != nil {
 			x.app.Flash().Errf("Delete failed with `%s", err)
 		} else {
 			x.app.Flash().Infof("%s `%s deleted successfully", x.GVR(), spec.Path())
@@ -45,6 +45,11 @@ func newScan(img string) *Scan {
 	return &Scan{ID: img, Table: newTable()}
 }
 
+// Score returns the image vulnerability score.
+func (s *Scan) Score() string {
+	return newScorer(s.Tally).String()
+}
+
 // Dump dump report to stdout.
 func (s *Scan) Dump(w io.Writer) error {
 	return s.Table.dump(w)
@@ -37,6 +37,9 @@ const (
 	imgChanSize     = 3
 	imgScanTimeout  = 2 * time.Second
 	scanConcurrency = 2
+
+	// imgEnqueueWindow tracks how long an enqueued image is not enqueued again.
+	imgEnqueueWindow = 10 * time.Second
 )
 
 type imageScanner struct {
@@ -44,6 +47,7 @@ type imageScanner struct {
 	status      *vulnerability.ProviderStatus
 	opts        *options.Grype
 	scans       Scans
+	enqueued    map[string]time.Time
 	mx          sync.RWMutex
 	initialized bool
 	config      config.ImageScans
@@ -53,9 +57,10 @@ type imageScanner struct {
 // NewImageScanner returns a new instance.
 func NewImageScanner(cfg config.ImageScans, l *slog.Logger) *imageScanner {
 	return &imageScanner{
-		scans:  make(Scans),
-		config: cfg,
-		log:    l.With(slogs.Subsys, "vul"),
+		scans:    make(Scans),
+		enqueued: make(map[string]time.Time),
+		config:   cfg,
+		log:      l.With(slogs.Subsys, "vul"),
 	}
 }
 
@@ -127,14 +132,29 @@ func (s *imageScanner) Stop() {
 }
 
 func (s *imageScanner) Score(ii ...string) string {
-	var sc scorer
+	return ScansScore(s.Scans(ii...))
+}
+
+// Scans returns the available scans for the given images.
+func (s *imageScanner) Scans(ii ...string) []*Scan {
+	ss := make([]*Scan, 0, len(ii))
 	for _, i := range ii {
 		if scan, ok := s.GetScan(i); ok {
-			sc = sc.Add(newScorer(scan.Tally))
+			ss = append(ss, scan)
 		}
 	}
 
-	return sc.String()
+	return ss
+}
+
+// ScansScore returns the worst score of the given scans.
+func ScansScore(ss []*Scan) string {
+	scores := make([]string, 0, len(ss))
+	for _, scan := range ss {
+		scores = append(scores, scan.Score())
+	}
+
+	return WorstScore(scores)
 }
 
 func (s *imageScanner) IsInitialized() bool {
@@ -145,15 +165,38 @@ func (s *imageScanner) IsInitialized() bool {
 }
 
 func (s *imageScanner) Enqueue(ctx context.Context, images ...string) {
+	ii := s.pending(time.Now(), images...)
+	if len(ii) == 0 {
+		return
+	}
+
 	ctx, cancel := context.WithTimeout(ctx, imgScanTimeout)
 	defer cancel()
 
+	for _, img := range ii {
+		go s.scanWorker(ctx, img)
+	}
+}
+
+// pending returns images that are neither scanned nor recently enqueued and marks them as enqueued.
+func (s *imageScanner) pending(now time.Time, images ...string) []string {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	var ii []string
 	for _, img := range images {
-		if _, ok := s.GetScan(img); ok {
+		if _, ok := s.scans[img]; ok {
+			delete(s.enqueued, img)
 			continue
 		}
-		go s.scanWorker(ctx, img)
+		if t, ok := s.enqueued[img]; ok && now.Sub(t) < imgEnqueueWindow {
+			continue
+		}
+		s.enqueued[img] = now
+		ii = append(ii, img)
 	}
+
+	return ii
 }
 
 func (s *imageScanner) scanWorker(ctx context.Context, img string) {
@@ -0,0 +1,28 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package vul
+
+import (
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScannerPending(t *testing.T) {
+	now := time.Now()
+	s := NewImageScanner(config.ImageScans{}, slog.Default())
+	s.setScan("scanned", newScan("scanned"))
+
+	assert.Equal(t, []string{"i1", "i2"}, s.pending(now, "i1", "scanned", "i2"))
+	assert.Empty(t, s.pending(now.Add(time.Second), "i1", "i2", "scanned"))
+	assert.Equal(t, []string{"i3"}, s.pending(now.Add(2*time.Second), "i1", "i3"))
+	assert.Equal(t, []string{"i1", "i2"}, s.pending(now.Add(imgEnqueueWindow), "i1", "i2"))
+
+	s.setScan("i1", newScan("i1"))
+	assert.Empty(t, s.pending(now.Add(3*imgEnqueueWindow), "i1"))
+	assert.NotContains(t, s.enqueued, "i1")
+}
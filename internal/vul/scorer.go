@@ -5,8 +5,59 @@ package vul
 
 import "fmt"
 
+// NoScore tracks a score without findings.
+const NoScore = "000000"
+
 type scorer uint8
 
+// IsScore checks if s is a well formed score.
+func IsScore(s string) bool {
+	if len(s) != len(NoScore) {
+		return false
+	}
+	for _, c := range s {
+		if c != '0' && c != '1' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// WorstScore returns the worst of the given scores.
+// Scores rank by their most severe finding ie Critical > High > Medium > Low > None,
+// ties going to the score with the most severe remaining findings.
+// Malformed scores are ignored.
+func WorstScore(ss []string) string {
+	worst := NoScore
+	for _, s := range ss {
+		if !IsScore(s) {
+			continue
+		}
+		// Scores are fixed width severity bit masks ordered from critical down.
+		if s > worst {
+			worst = s
+		}
+	}
+
+	return worst
+}
+
+// ScoreSeverity returns the worst severity flagged in a score.
+// Malformed scores carry no severity.
+func ScoreSeverity(s string) Severity {
+	if !IsScore(s) {
+		return SeverityNone
+	}
+	for i, sev := range []Severity{SeverityCritical, SeverityHigh, SeverityMedium, SeverityLow, SeverityNegligible, SeverityUnknown} {
+		if s[i] == '1' {
+			return sev
+		}
+	}
+
+	return SeverityNone
+}
+
 func (b scorer) String() string {
 	return fmt.Sprintf("%08b", b)[:6]
 }
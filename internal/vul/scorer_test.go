@@ -94,3 +94,96 @@ func Test_scorerFromTally(t *testing.T) {
 		})
 	}
 }
+
+func TestScansScore(t *testing.T) {
+	uu := map[string]struct {
+		ss []*Scan
+		e  string
+	}{
+		"none": {
+			e: "000000",
+		},
+		"worst": {
+			ss: []*Scan{
+				{Tally: tally{1, 0, 0, 0, 0, 0, 0}},
+				{Tally: tally{0, 0, 2, 0, 0, 0, 0}},
+			},
+			e: "100000",
+		},
+		"tie": {
+			ss: []*Scan{
+				{Tally: tally{1, 0, 0, 0, 0, 0, 0}},
+				{Tally: tally{1, 0, 0, 1, 0, 0, 0}},
+			},
+			e: "100100",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, ScansScore(u.ss))
+		})
+	}
+}
+
+func TestScoreSeverity(t *testing.T) {
+	uu := map[string]struct {
+		s   string
+		sev Severity
+	}{
+		"none": {
+			s:   NoScore,
+			sev: SeverityNone,
+		},
+		"critical": {
+			s:   "100100",
+			sev: SeverityCritical,
+		},
+		"high": {
+			s:   "011000",
+			sev: SeverityHigh,
+		},
+		"unknown": {
+			s:   "000001",
+			sev: SeverityUnknown,
+		},
+		"malformed": {
+			s:   "n/a",
+			sev: SeverityNone,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.sev, ScoreSeverity(u.s))
+		})
+	}
+}
+
+func TestIsScore(t *testing.T) {
+	uu := map[string]struct {
+		s string
+		e bool
+	}{
+		"none":    {s: NoScore, e: true},
+		"score":   {s: "101000", e: true},
+		"empty":   {},
+		"short":   {s: "101"},
+		"long":    {s: "1010000"},
+		"no-bits": {s: "10a000"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, IsScore(u.s))
+		})
+	}
+}
+
+func TestScanScore(t *testing.T) {
+	s := Scan{Tally: tally{0, 1, 0, 3, 0, 0, 0}}
+	assert.Equal(t, "010100", s.Score())
+}
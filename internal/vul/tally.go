@@ -64,6 +64,25 @@ func (t tally) Dump(w io.Writer) {
 	}
 }
 
+var sevIndex = map[string]int{
+	Sev1: sevCritical,
+	Sev2: sevHigh,
+	Sev3: sevMedium,
+	Sev4: sevLow,
+	Sev5: sevNegligible,
+	SevU: sevUnknown,
+}
+
+// Count returns the number of vulnerabilities for a given severity.
+func (t tally) Count(sev string) int {
+	i, ok := sevIndex[sev]
+	if !ok {
+		return 0
+	}
+
+	return t[i]
+}
+
 func (t *tally) score() int {
 	var s int
 	for i, v := range t[:5] {
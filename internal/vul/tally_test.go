@@ -55,3 +55,26 @@ func Test_score(t *testing.T) {
 		})
 	}
 }
+
+func Test_tallyCount(t *testing.T) {
+	tt := tally{1, 2, 3, 4, 5, 6, 7}
+	uu := map[string]struct {
+		sev string
+		e   int
+	}{
+		"critical": {sev: Sev1, e: 1},
+		"high":     {sev: Sev2, e: 2},
+		"medium":   {sev: Sev3, e: 3},
+		"low":      {sev: Sev4, e: 4},
+		"neg":      {sev: Sev5, e: 5},
+		"unknown":  {sev: SevU, e: 6},
+		"bozo":     {sev: "bozo"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, tt.Count(u.sev))
+		})
+	}
+}
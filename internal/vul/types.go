@@ -22,3 +22,29 @@ const (
 	// SevU tracks Unknown sev.
 	SevU = "SEV-U"
 )
+
+// Severity tracks a vulnerability severity level.
+type Severity int
+
+const (
+	// SeverityNone represents no known vulnerabilities.
+	SeverityNone Severity = iota
+
+	// SeverityUnknown represents vulnerabilities of unknown severity.
+	SeverityUnknown
+
+	// SeverityNegligible represents negligible vulnerabilities.
+	SeverityNegligible
+
+	// SeverityLow represents low vulnerabilities.
+	SeverityLow
+
+	// SeverityMedium represents medium vulnerabilities.
+	SeverityMedium
+
+	// SeverityHigh represents high vulnerabilities.
+	SeverityHigh
+
+	// SeverityCritical represents critical vulnerabilities.
+	SeverityCritical
+)
@@ -31,6 +31,7 @@ type Factory struct {
 	client     client.Connection
 	stopChan   chan struct{}
 	forwarders Forwarders
+	watched    map[string]struct{}
 	mx         sync.RWMutex
 }
 
@@ -40,6 +41,7 @@ func NewFactory(clt client.Connection) *Factory {
 		client:     clt,
 		factories:  make(map[string]di.DynamicSharedInformerFactory),
 		forwarders: NewForwarders(),
+		watched:    make(map[string]struct{}),
 	}
 }
 
@@ -68,6 +70,7 @@ func (f *Factory) Terminate() {
 	for k := range f.factories {
 		delete(f.factories, k)
 	}
+	clear(f.watched)
 	f.forwarders.DeleteAll()
 }
 
@@ -231,13 +234,74 @@ func (f *Factory) ForResource(ns string, gvr *client.GVR) (informers.GenericInfo
 		return inf, nil
 	}
 
+	key := watchKey(ns, gvr)
 	f.mx.RLock()
-	defer f.mx.RUnlock()
+	_, ok := f.watched[key]
 	fact.Start(f.stopChan)
+	f.mx.RUnlock()
+	if !ok {
+		f.mx.Lock()
+		f.watched[key] = struct{}{}
+		f.mx.Unlock()
+	}
 
 	return inf, nil
 }
 
+// Count returns the number of cached resources for a given gvr and namespace.
+// Only resources already watched are counted, no new informers are started.
+func (f *Factory) Count(gvr *client.GVR, ns string) (int, bool) {
+	if client.IsAllNamespace(ns) {
+		ns = client.BlankNamespace
+	}
+	inf, ok := f.watchedInformer(gvr, ns)
+	if !ok || !inf.Informer().HasSynced() {
+		return 0, false
+	}
+
+	var (
+		oo  []runtime.Object
+		err error
+	)
+	if client.IsNamespaced(ns) {
+		oo, err = inf.Lister().ByNamespace(ns).List(labels.Everything())
+	} else {
+		oo, err = inf.Lister().List(labels.Everything())
+	}
+	if err != nil {
+		return 0, false
+	}
+
+	return len(oo), true
+}
+
+func (f *Factory) watchedInformer(gvr *client.GVR, ns string) (informers.GenericInformer, bool) {
+	f.mx.RLock()
+	defer f.mx.RUnlock()
+
+	for _, fns := range []string{ns, client.BlankNamespace} {
+		if client.IsClusterWide(fns) {
+			fns = client.BlankNamespace
+		}
+		if _, ok := f.watched[watchKey(fns, gvr)]; !ok {
+			continue
+		}
+		if fac, ok := f.factories[fns]; ok {
+			return fac.ForResource(gvr.GVR()), true
+		}
+	}
+
+	return nil, false
+}
+
+func watchKey(ns string, gvr *client.GVR) string {
+	if client.IsClusterWide(ns) {
+		ns = client.BlankNamespace
+	}
+
+	return ns + "|" + gvr.String()
+}
+
 func (f *Factory) ensureFactory(ns string) (di.DynamicSharedInformerFactory, error) {
 	if client.IsClusterWide(ns) {
 		ns = client.BlankNamespace
@@ -50,6 +50,17 @@ type Forwarder interface {
 	HasPortMapping(string) bool
 }
 
+// ServiceForwarder represents a port forwarder backing a service.
+type ServiceForwarder interface {
+	Forwarder
+
+	// Service returns the forwarded service path.
+	Service() string
+
+	// Pod returns the pod currently backing the forward.
+	Pod() string
+}
+
 // Forwarders tracks active port forwards.
 type Forwarders map[string]Forwarder
 
@@ -70,6 +81,17 @@ func (ff Forwarders) IsPodForwarded(fqn string) bool {
 	return false
 }
 
+// ServicePod returns the pod currently backing a service forward if any.
+func (ff Forwarders) ServicePod(svc string) (string, bool) {
+	for _, f := range ff {
+		if sf, ok := f.(ServiceForwarder); ok && sf.Service() == svc {
+			return sf.Pod(), true
+		}
+	}
+
+	return "", false
+}
+
 // IsContainerForwarded checks if pod has a forward.
 func (ff Forwarders) IsContainerForwarded(fqn, co string) bool {
 	fqn += "|" + co
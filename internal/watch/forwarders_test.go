@@ -53,6 +53,45 @@ func TestIsPodForwarded(t *testing.T) {
 	}
 }
 
+func TestServicePod(t *testing.T) {
+	uu := map[string]struct {
+		ff       watch.Forwarders
+		svc, pod string
+		ok       bool
+	}{
+		"happy": {
+			ff: watch.Forwarders{
+				"ns1/p1||8080:8080": newNoOpForwarder(),
+				"ns1/p2||8080:8080": noOpSvcForwarder{svc: "ns1/s1", pod: "ns1/p2"},
+			},
+			svc: "ns1/s1",
+			pod: "ns1/p2",
+			ok:  true,
+		},
+		"other-svc": {
+			ff: watch.Forwarders{
+				"ns1/p2||8080:8080": noOpSvcForwarder{svc: "ns1/s2", pod: "ns1/p2"},
+			},
+			svc: "ns1/s1",
+		},
+		"pod-only": {
+			ff: watch.Forwarders{
+				"ns1/s1||8080:8080": newNoOpForwarder(),
+			},
+			svc: "ns1/s1",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			pod, ok := u.ff.ServicePod(u.svc)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.pod, pod)
+		})
+	}
+}
+
 func TestIsContainerForwarded(t *testing.T) {
 	uu := map[string]struct {
 		ff      watch.Forwarders
@@ -186,3 +225,12 @@ func (noOpForwarder) SetActive(bool)             {}
 func (noOpForwarder) Age() time.Time             { return time.Now() }
 func (noOpForwarder) HasPortMapping(string) bool { return false }
 func (noOpForwarder) Address() string            { return "" }
+
+type noOpSvcForwarder struct {
+	noOpForwarder
+
+	svc, pod string
+}
+
+func (s noOpSvcForwarder) Service() string { return s.svc }
+func (s noOpSvcForwarder) Pod() string     { return s.pod }